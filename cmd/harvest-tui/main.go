@@ -1,22 +1,109 @@
 package main
 
 import (
+	"context"
+	"flag"
 	"fmt"
 	"os"
+	"os/signal"
+	"syscall"
+	"time"
 
 	tea "github.com/charmbracelet/bubbletea"
+	"github.com/planetargon/harvest-tui/internal/cli"
 	"github.com/planetargon/harvest-tui/internal/config"
+	"github.com/planetargon/harvest-tui/internal/daemon"
 	"github.com/planetargon/harvest-tui/internal/harvest"
+	"github.com/planetargon/harvest-tui/internal/harvest/harvestfake"
+	"github.com/planetargon/harvest-tui/internal/harvest/harvesttest"
 	"github.com/planetargon/harvest-tui/internal/state"
 	"github.com/planetargon/harvest-tui/internal/tui"
 )
 
+// baseURLEnv overrides the Harvest API base URL, e.g. to point at a local fake server.
+const baseURLEnv = "HARVEST_TUI_BASE_URL"
+
 func main() {
-	// Load configuration
-	cfg, err := config.Load()
-	if err != nil {
-		fmt.Printf("Error: %v\n", err)
-		os.Exit(1)
+	fakeServer := flag.Bool("fake-server", false, "run against an in-process fake Harvest API seeded with demo data")
+	flag.Usage = func() {
+		fmt.Fprint(flag.CommandLine.Output(), cli.Usage+"\nOptions:\n")
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+
+	var cfg *config.Config
+	var server *harvesttest.Server
+	baseURL := os.Getenv(baseURLEnv)
+	if *fakeServer {
+		// Serve demo data locally so the app can be tried without a Harvest account
+		server = harvesttest.NewServer(harvestfake.NewDemo(time.Now()))
+		defer server.Close()
+		baseURL = server.URL
+		cfg = &config.Config{
+			Harvest: config.HarvestConfig{AccountID: harvesttest.AccountID, AccessToken: harvesttest.AccessToken},
+			Retry:   config.DefaultRetryConfig(),
+			Timer:   config.DefaultTimerConfig(),
+			Prompt:  config.DefaultPromptConfig(),
+			Export:  config.DefaultExportConfig(),
+		}
+	} else {
+		// Load configuration
+		var err error
+		cfg, err = config.Load()
+		if err != nil {
+			fmt.Printf("Error: %v\n", err)
+			os.Exit(1)
+		}
+	}
+
+	// Initialize Harvest client
+	harvestClient := newHarvestClient(cfg, baseURL)
+
+	// Serve editors and other instances instead of opening the TUI
+	if flag.Arg(0) == "daemon" {
+		if flag.NArg() > 1 {
+			fmt.Println("Error: daemon takes no arguments")
+			os.Exit(cli.ExitUsage)
+		}
+		code := runDaemon(cfg, harvestClient)
+		if server != nil {
+			server.Close()
+		}
+		os.Exit(code)
+	}
+
+	// Run a subcommand instead of the TUI when one is given
+	if flag.NArg() > 0 {
+		var client harvest.API = harvestClient
+		var fallback *daemon.Fallback
+		if server == nil {
+			// Look for a daemon only once a subcommand actually calls Harvest
+			if path, err := daemon.SocketPath(); err == nil {
+				fallback = daemon.NewFallback(path, cfg.Harvest.AccountID, harvestClient)
+				client = fallback
+			}
+		}
+		code := cli.New(client, os.Stdout, os.Stderr).
+			WithRunningCache(cfg.Harvest.AccountID, cfg.Prompt.RefreshInterval).
+			WithExportDefaults(cfg.Export.Columns, cfg.Export.Rounding()).
+			Run(context.Background(), flag.Args())
+		if fallback != nil {
+			fallback.Close()
+		}
+		if server != nil {
+			server.Close()
+		}
+		os.Exit(code)
+	}
+
+	// Share a running daemon's signed-in client rather than signing in again
+	var client harvest.API = harvestClient
+	var session *daemon.SessionReply
+	if server == nil {
+		if daemonClient, daemonSession := dialDaemon(cfg.Harvest.AccountID); daemonClient != nil {
+			defer daemonClient.Close()
+			client, session = daemonClient, daemonSession
+		}
 	}
 
 	// Load application state
@@ -26,26 +113,54 @@ func main() {
 		os.Exit(1)
 	}
 
-	// Initialize Harvest client
-	harvestClient := harvest.NewClient(cfg.Harvest.AccountID, cfg.Harvest.AccessToken)
+	var user *harvest.User
+	var company *harvest.Company
+	if session != nil {
+		user, company = &session.User, &session.Company
+	} else {
+		// Validate authentication before starting TUI
+		user, err = harvestClient.ValidateAuth()
+		if err != nil {
+			fmt.Printf("Authentication failed: %v\n", err)
+			fmt.Println("Please check your Harvest credentials in ~/.config/harvest-tui/config.toml")
+			fmt.Printf("\nTo get started, set up your Harvest API credentials:\n%s\n", config.SetupInstructionsURL)
+			os.Exit(1)
+		}
 
-	// Validate authentication before starting TUI
-	user, err := harvestClient.ValidateAuth()
-	if err != nil {
-		fmt.Printf("Authentication failed: %v\n", err)
-		fmt.Println("Please check your Harvest credentials in ~/.config/harvest-tui/config.toml")
-		fmt.Printf("\nTo get started, set up your Harvest API credentials:\n%s\n", config.SetupInstructionsURL)
-		os.Exit(1)
+		// Load account settings so hours, dates and weeks match Harvest's web app
+		company, err = harvestClient.FetchCompany()
+		if err != nil {
+			fmt.Printf("Warning: Could not load company settings, using defaults: %v\n", err)
+		}
 	}
 
 	fmt.Printf("Welcome, %s!\n", user.FirstName+" "+user.LastName)
 	fmt.Printf("Starting Harvest TUI...\n")
 
+	// Start from cached projects, if any, while a fresh list loads in the background
+	var cachedProjects []harvest.ProjectWithTasks
+	projectCache, err := state.LoadProjectCache(cfg.Harvest.AccountID)
+	if err != nil {
+		fmt.Printf("Warning: Could not load cached projects: %v\n", err)
+	} else if projectCache != nil {
+		cachedProjects = projectCache.Projects
+	}
+
 	// Initialize TUI model
-	model := tui.NewModel(cfg, harvestClient, appState, user)
+	model := tui.NewModel(cfg, client, appState, user).
+		WithCompany(company).
+		WithCachedProjects(cachedProjects)
 
 	// Create and run the program
 	p := tea.NewProgram(model, tea.WithAltScreen())
+
+	// Show a throttled indicator while requests wait on the client-side rate
+	// limit. Through a daemon, its client does the waiting.
+	if session == nil {
+		harvestClient.SetThrottleHandler(func(throttled bool) {
+			p.Send(tui.ThrottleMsg{Throttled: throttled})
+		})
+	}
 	if _, err := p.Run(); err != nil {
 		fmt.Printf("Error: %v\n", err)
 		os.Exit(1)
@@ -56,3 +171,77 @@ func main() {
 		fmt.Printf("Warning: Could not save state: %v\n", err)
 	}
 }
+
+// newHarvestClient creates a Harvest client from the config, pointed at
+// baseURL when it is set.
+func newHarvestClient(cfg *config.Config, baseURL string) *harvest.Client {
+	harvestClient := harvest.NewClient(cfg.Harvest.AccountID, cfg.Harvest.AccessToken)
+	if baseURL != "" {
+		harvestClient.SetBaseURL(baseURL)
+	}
+	harvestClient.SetRetryPolicy(harvest.RetryPolicy{
+		MaxRetries: cfg.Retry.MaxRetries,
+		BaseDelay:  cfg.Retry.BaseDelay,
+		MaxDelay:   cfg.Retry.MaxDelay,
+	})
+	return harvestClient
+}
+
+// dialDaemon connects to a running daemon signed in to accountID. It returns
+// nil when there is none, so the caller signs in itself.
+func dialDaemon(accountID string) (*daemon.Client, *daemon.SessionReply) {
+	path, err := daemon.SocketPath()
+	if err != nil {
+		return nil, nil
+	}
+	client, err := daemon.Dial(path)
+	if err != nil {
+		return nil, nil
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	session, err := client.Session(ctx)
+	if err != nil || session.AccountID != accountID {
+		client.Close()
+		return nil, nil
+	}
+	return client, session
+}
+
+// runDaemon signs in once and serves the Harvest client on the daemon socket
+// until interrupted, returning the process exit code.
+func runDaemon(cfg *config.Config, harvestClient *harvest.Client) int {
+	user, err := harvestClient.ValidateAuth()
+	if err != nil {
+		fmt.Printf("Authentication failed: %v\n", err)
+		fmt.Println("Please check your Harvest credentials in ~/.config/harvest-tui/config.toml")
+		return 1
+	}
+	company, err := harvestClient.FetchCompany()
+	if err != nil {
+		fmt.Printf("Warning: Could not load company settings, using defaults: %v\n", err)
+		company = &harvest.Company{}
+	}
+
+	path, err := daemon.SocketPath()
+	if err != nil {
+		fmt.Printf("Error: could not determine socket path: %v\n", err)
+		return 1
+	}
+	listener, err := daemon.Listen(path)
+	if err != nil {
+		fmt.Printf("Error: %v\n", err)
+		return 1
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	fmt.Printf("Signed in as %s, listening on %s\n", user.FirstName+" "+user.LastName, path)
+	server := daemon.NewServer(harvestClient, cfg.Harvest.AccountID, *user, *company)
+	if err := server.Serve(ctx, listener); err != nil {
+		fmt.Printf("Error: %v\n", err)
+		return 1
+	}
+	return 0
+}
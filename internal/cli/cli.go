@@ -0,0 +1,438 @@
+// Package cli implements the non-interactive subcommands, such as
+// "harvest-tui status", for scripts and git hooks.
+package cli
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/planetargon/harvest-tui/internal/duration"
+	"github.com/planetargon/harvest-tui/internal/export"
+	"github.com/planetargon/harvest-tui/internal/harvest"
+)
+
+// Exit codes returned by Run.
+const (
+	ExitOK    = 0 // The command succeeded
+	ExitError = 1 // Harvest failed the request, or there was nothing to act on
+	ExitUsage = 2 // The arguments were invalid
+)
+
+// Usage describes the subcommands.
+const Usage = `Usage: harvest-tui [command]
+
+Without a command, harvest-tui opens the full-screen app.
+
+Commands:
+  status                                 Show the running timer
+  start <project/task> [notes]           Start a timer
+  stop                                   Stop the running timer
+  log <duration> <project/task> [notes]  Log time, e.g. log 1:30 Website/Development "Standup"
+      --date YYYY-MM-DD                  Day to log to (default today)
+  list                                   List time entries
+      --date YYYY-MM-DD                  Day to list (default today)
+  prompt                                 Print the running timer for shell prompts, or nothing
+      --format TEMPLATE                  e.g. "{client} › {task} {elapsed}" (the default)
+  export                                 Write entries for a date range as CSV, e.g. export > january.csv
+      --from YYYY-MM-DD                  First day (default the 1st of this month)
+      --to YYYY-MM-DD                    Last day (default today)
+      --columns date,client,...          Columns to write, in order (default from config)
+      --round-to 15m                     Round each entry's hours to a multiple (default from config)
+      --round-mode nearest|up|down       Which way to round (default from config)
+  daemon                                 Stay signed in and serve editors and the app over a socket
+
+Every command but prompt and export takes --output (or -o) table, json or csv to choose
+the format of its result. JSON and CSV follow a stable schema, documented in the README.
+Flags may follow the arguments; put notes after -- to keep words like -o out of the flags.
+
+Projects and tasks are matched by name, ignoring case. A unique part of a name
+is enough, and "Client/Project/Task" picks between projects with the same name.
+`
+
+// usageError is an error in the command line rather than from Harvest.
+type usageError struct {
+	msg string
+}
+
+func (e usageError) Error() string {
+	return e.msg
+}
+
+func usagef(format string, args ...any) error {
+	return usageError{msg: fmt.Sprintf(format, args...)}
+}
+
+// errNoTimer is returned by stop when no timer is running.
+var errNoTimer = errors.New("no timer is running")
+
+// CLI runs subcommands against a Harvest API, writing results to stdout and
+// errors to stderr.
+type CLI struct {
+	client harvest.API
+	stdout io.Writer
+	stderr io.Writer
+	now    func() time.Time
+
+	// Running timer cache for prompt, off while accountID is empty
+	accountID       string
+	refreshInterval time.Duration
+
+	// Defaults for export
+	exportColumns []string
+	rounding      export.Rounding
+}
+
+// New creates a CLI. client is usually a *harvest.Client, but any harvest.API
+// such as harvestfake works.
+func New(client harvest.API, stdout, stderr io.Writer) *CLI {
+	return &CLI{client: client, stdout: stdout, stderr: stderr, now: time.Now, exportColumns: export.DefaultColumns}
+}
+
+// Run runs the subcommand named by args[0] and returns the process exit code.
+func (c *CLI) Run(ctx context.Context, args []string) int {
+	if len(args) == 0 {
+		fmt.Fprint(c.stderr, Usage)
+		return ExitUsage
+	}
+
+	var err error
+	switch args[0] {
+	case "status":
+		err = c.status(ctx, args[1:])
+	case "start":
+		err = c.start(ctx, args[1:])
+	case "stop":
+		err = c.stop(ctx, args[1:])
+	case "log":
+		err = c.log(ctx, args[1:])
+	case "list":
+		err = c.list(ctx, args[1:])
+	case "prompt":
+		err = c.prompt(ctx, args[1:])
+	case "export":
+		err = c.exportCSV(ctx, args[1:])
+	case "help", "-h", "--help":
+		fmt.Fprint(c.stdout, Usage)
+		return ExitOK
+	default:
+		err = usagef("unknown command %q", args[0])
+	}
+
+	var usageErr usageError
+	switch {
+	case err == nil:
+		return ExitOK
+	case errors.As(err, &usageErr):
+		fmt.Fprintf(c.stderr, "Error: %v\nRun 'harvest-tui help' for usage.\n", err)
+		return ExitUsage
+	default:
+		fmt.Fprintf(c.stderr, "Error: %v\n", err)
+		return ExitError
+	}
+}
+
+// parseArgs parses flags anywhere among args, so they can follow the
+// positional arguments as in "log 1:30 Website/Development --date 2025-01-15".
+// Once a positional argument is seen, only the command's own flags are parsed,
+// so notes may contain words like "-v"; everything after "--" is positional.
+func parseArgs(fs *flag.FlagSet, args []string) ([]string, error) {
+	fs.SetOutput(io.Discard)
+	var positional []string
+	for {
+		if err := fs.Parse(args); err != nil {
+			return nil, usageError{msg: err.Error()}
+		}
+		rest := fs.Args()
+		if len(rest) == 0 {
+			return positional, nil
+		}
+		if len(rest) < len(args) && args[len(args)-len(rest)-1] == "--" {
+			return append(positional, rest...), nil
+		}
+		for len(rest) > 0 && !isFlag(fs, rest[0]) {
+			if rest[0] == "--" {
+				return append(positional, rest[1:]...), nil
+			}
+			positional = append(positional, rest[0])
+			rest = rest[1:]
+		}
+		args = rest
+	}
+}
+
+// isFlag reports whether arg names one of the flags defined in fs, as in
+// "-o", "--date" or "--date=2025-01-15".
+func isFlag(fs *flag.FlagSet, arg string) bool {
+	name, ok := strings.CutPrefix(arg, "-")
+	if !ok {
+		return false
+	}
+	name = strings.TrimPrefix(name, "-")
+	name, _, _ = strings.Cut(name, "=")
+	return name != "" && fs.Lookup(name) != nil
+}
+
+// dateFlag adds a --date flag defaulting to today.
+func (c *CLI) dateFlag(fs *flag.FlagSet) *string {
+	return fs.String("date", c.now().Format("2006-01-02"), "day as YYYY-MM-DD")
+}
+
+func validateDate(date string) error {
+	if _, err := time.Parse("2006-01-02", date); err != nil {
+		return usagef("invalid date %q, use YYYY-MM-DD", date)
+	}
+	return nil
+}
+
+func (c *CLI) status(ctx context.Context, args []string) error {
+	fs := flag.NewFlagSet("status", flag.ContinueOnError)
+	output := outputFlag(fs)
+	args, err := parseArgs(fs, args)
+	if err != nil {
+		return err
+	}
+	if len(args) > 0 {
+		return usagef("status takes no arguments")
+	}
+	format, err := parseFormat(*output)
+	if err != nil {
+		return err
+	}
+
+	entry, err := c.client.FetchRunningTimeEntryContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	switch format {
+	case export.FormatJSON:
+		var status export.Status
+		if entry != nil {
+			running := export.FromTimeEntry(*entry)
+			status.Running = &running
+		}
+		return export.WriteJSON(c.stdout, status)
+	case export.FormatCSV:
+		var rows []harvest.TimeEntry
+		if entry != nil {
+			rows = append(rows, *entry)
+		}
+		return export.WriteCSV(c.stdout, export.FromTimeEntries(rows))
+	}
+
+	if entry == nil {
+		fmt.Fprintln(c.stdout, "No timer running")
+		return nil
+	}
+	fmt.Fprintf(c.stdout, "Running: %s  %s\n", entryPath(*entry), export.FormatDuration(entry.Hours))
+	if entry.SpentDate != c.now().Format("2006-01-02") {
+		fmt.Fprintf(c.stdout, "Date: %s\n", entry.SpentDate)
+	}
+	if entry.Notes != "" {
+		fmt.Fprintf(c.stdout, "Notes: %s\n", entry.Notes)
+	}
+	return nil
+}
+
+func (c *CLI) start(ctx context.Context, args []string) error {
+	fs := flag.NewFlagSet("start", flag.ContinueOnError)
+	output := outputFlag(fs)
+	args, err := parseArgs(fs, args)
+	if err != nil {
+		return err
+	}
+	if len(args) == 0 {
+		return usagef("start needs a project/task")
+	}
+	format, err := parseFormat(*output)
+	if err != nil {
+		return err
+	}
+	project, task, err := c.findProjectTask(ctx, args[0])
+	if err != nil {
+		return err
+	}
+
+	// Leaving hours out starts a running timer
+	entry, err := c.client.CreateTimeEntryContext(ctx, harvest.CreateTimeEntryRequest{
+		ProjectID: project.ID,
+		TaskID:    task.ID,
+		SpentDate: c.now().Format("2006-01-02"),
+		Notes:     strings.Join(args[1:], " "),
+	})
+	if err != nil {
+		return err
+	}
+	c.saveRunningCache(entry, c.now())
+	return c.writeEntry(format, *entry, "Started timer: %[1]s\n")
+}
+
+func (c *CLI) stop(ctx context.Context, args []string) error {
+	fs := flag.NewFlagSet("stop", flag.ContinueOnError)
+	output := outputFlag(fs)
+	args, err := parseArgs(fs, args)
+	if err != nil {
+		return err
+	}
+	if len(args) > 0 {
+		return usagef("stop takes no arguments")
+	}
+	format, err := parseFormat(*output)
+	if err != nil {
+		return err
+	}
+
+	running, err := c.client.FetchRunningTimeEntryContext(ctx)
+	if err != nil {
+		return err
+	}
+	if running == nil {
+		return errNoTimer
+	}
+
+	entry, err := c.client.StopTimeEntryContext(ctx, running.ID)
+	if err != nil {
+		return err
+	}
+	c.saveRunningCache(nil, c.now())
+	return c.writeEntry(format, *entry, "Stopped timer: %[1]s  %[2]s\n")
+}
+
+func (c *CLI) log(ctx context.Context, args []string) error {
+	fs := flag.NewFlagSet("log", flag.ContinueOnError)
+	date := c.dateFlag(fs)
+	output := outputFlag(fs)
+	args, err := parseArgs(fs, args)
+	if err != nil {
+		return err
+	}
+	if len(args) < 2 {
+		return usagef("log needs a duration and a project/task")
+	}
+	if err := validateDate(*date); err != nil {
+		return err
+	}
+	format, err := parseFormat(*output)
+	if err != nil {
+		return err
+	}
+	hours, err := duration.Parse(args[0])
+	if err != nil {
+		return usageError{msg: err.Error()}
+	}
+	// Zero hours would start a timer instead
+	if hours == 0 {
+		return usagef("duration must be more than 0:00, use start for a timer")
+	}
+	project, task, err := c.findProjectTask(ctx, args[1])
+	if err != nil {
+		return err
+	}
+
+	entry, err := c.client.CreateTimeEntryContext(ctx, harvest.CreateTimeEntryRequest{
+		ProjectID: project.ID,
+		TaskID:    task.ID,
+		SpentDate: *date,
+		Hours:     hours,
+		Notes:     strings.Join(args[2:], " "),
+	})
+	if err != nil {
+		return err
+	}
+	return c.writeEntry(format, *entry, "Logged %[2]s to %[1]s on %[3]s\n")
+}
+
+func (c *CLI) list(ctx context.Context, args []string) error {
+	fs := flag.NewFlagSet("list", flag.ContinueOnError)
+	date := c.dateFlag(fs)
+	output := outputFlag(fs)
+	args, err := parseArgs(fs, args)
+	if err != nil {
+		return err
+	}
+	if len(args) > 0 {
+		return usagef("list takes no arguments besides its flags")
+	}
+	if err := validateDate(*date); err != nil {
+		return err
+	}
+	format, err := parseFormat(*output)
+	if err != nil {
+		return err
+	}
+
+	entries, err := c.client.FetchTimeEntriesContext(ctx, *date)
+	if err != nil {
+		return err
+	}
+
+	switch format {
+	case export.FormatJSON:
+		exported := export.FromTimeEntries(entries)
+		return export.WriteJSON(c.stdout, export.Day{Date: *date, Entries: exported, Totals: export.Sum(exported)})
+	case export.FormatCSV:
+		return export.WriteCSV(c.stdout, export.FromTimeEntries(entries))
+	}
+
+	if len(entries) == 0 {
+		fmt.Fprintf(c.stdout, "No time entries on %s\n", *date)
+		return nil
+	}
+	w := tabwriter.NewWriter(c.stdout, 0, 0, 2, ' ', 0)
+	total := 0.0
+	for _, entry := range entries {
+		marker := " "
+		if entry.IsRunning {
+			marker = "●"
+		}
+		line := marker + " " + export.FormatDuration(entry.Hours) + "\t" + entryPath(entry)
+		if entry.Notes != "" {
+			line += "\t" + entry.Notes
+		}
+		fmt.Fprintln(w, line)
+		total += entry.Hours
+	}
+	fmt.Fprintf(w, "  %s\tTotal\n", export.FormatDuration(total))
+	return w.Flush()
+}
+
+// outputFlag adds an --output flag, or -o for short, choosing the format of
+// the command's result.
+func outputFlag(fs *flag.FlagSet) *string {
+	output := fs.String("output", string(export.FormatTable), "table, json or csv")
+	fs.StringVar(output, "o", string(export.FormatTable), "short for --output")
+	return output
+}
+
+func parseFormat(output string) (export.Format, error) {
+	format, err := export.ParseFormat(output)
+	if err != nil {
+		return "", usageError{msg: err.Error()}
+	}
+	return format, nil
+}
+
+// writeEntry writes the entry a command acted on. The table format is the
+// message given by layout, with the entry's path, duration and date as its
+// arguments.
+func (c *CLI) writeEntry(format export.Format, entry harvest.TimeEntry, layout string) error {
+	switch format {
+	case export.FormatJSON:
+		return export.WriteJSON(c.stdout, export.FromTimeEntry(entry))
+	case export.FormatCSV:
+		return export.WriteCSV(c.stdout, []export.Entry{export.FromTimeEntry(entry)})
+	}
+	_, err := fmt.Fprintf(c.stdout, layout, entryPath(entry), export.FormatDuration(entry.Hours), entry.SpentDate)
+	return err
+}
+
+// entryPath formats an entry's client, project and task.
+func entryPath(entry harvest.TimeEntry) string {
+	return entry.Client.Name + " → " + entry.Project.Name + " → " + entry.Task.Name
+}
@@ -0,0 +1,335 @@
+package cli
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/planetargon/harvest-tui/internal/export"
+	"github.com/planetargon/harvest-tui/internal/harvest"
+	"github.com/planetargon/harvest-tui/internal/harvest/harvestfake"
+)
+
+// newTestCLI returns a CLI on 2025-01-15 backed by a fake with two clients'
+// projects, and buffers capturing its output.
+func newTestCLI() (*CLI, *harvestfake.Client, *bytes.Buffer, *bytes.Buffer) {
+	fake := harvestfake.New()
+	fake.AddProject(
+		harvest.Project{ID: 10, Name: "Website", Client: harvest.ProjectClient{ID: 1, Name: "Acme"}},
+		harvest.Task{ID: 100, Name: "Development", Billable: true},
+		harvest.Task{ID: 101, Name: "Design", Billable: true},
+	)
+	fake.AddProject(
+		harvest.Project{ID: 20, Name: "Website", Client: harvest.ProjectClient{ID: 2, Name: "Globex"}},
+		harvest.Task{ID: 100, Name: "Development"},
+	)
+	fake.AddProject(
+		harvest.Project{ID: 30, Name: "Mobile App", Client: harvest.ProjectClient{ID: 2, Name: "Globex"}},
+		harvest.Task{ID: 100, Name: "Development"},
+	)
+
+	var stdout, stderr bytes.Buffer
+	c := New(fake, &stdout, &stderr)
+	c.now = func() time.Time { return time.Date(2025, 1, 15, 9, 0, 0, 0, time.Local) }
+	return c, fake, &stdout, &stderr
+}
+
+func TestCommands(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("given no running timer when status run then says so and exits 0", func(t *testing.T) {
+		c, _, stdout, _ := newTestCLI()
+
+		if code := c.Run(ctx, []string{"status"}); code != ExitOK {
+			t.Fatalf("expected exit 0, got %d", code)
+		}
+		if stdout.String() != "No timer running\n" {
+			t.Errorf("expected no timer message, got %q", stdout.String())
+		}
+	})
+
+	t.Run("given start with project and task when run then starts a timer with the notes", func(t *testing.T) {
+		c, fake, stdout, _ := newTestCLI()
+
+		if code := c.Run(ctx, []string{"start", "mobile/dev", "Fix", "login"}); code != ExitOK {
+			t.Fatalf("expected exit 0, got %d", code)
+		}
+
+		running, _ := fake.FetchRunningTimeEntryContext(ctx)
+		if running == nil || running.Project.ID != 30 || running.Task.ID != 100 || running.Notes != "Fix login" {
+			t.Fatalf("expected timer on Mobile App/Development, got %+v", running)
+		}
+		if running.SpentDate != "2025-01-15" {
+			t.Errorf("expected timer on today, got %s", running.SpentDate)
+		}
+		if stdout.String() != "Started timer: Globex → Mobile App → Development\n" {
+			t.Errorf("unexpected output %q", stdout.String())
+		}
+
+		stdout.Reset()
+		c.Run(ctx, []string{"status"})
+		if !strings.HasPrefix(stdout.String(), "Running: Globex → Mobile App → Development  0:00\n") ||
+			!strings.Contains(stdout.String(), "Notes: Fix login") {
+			t.Errorf("expected running status, got %q", stdout.String())
+		}
+	})
+
+	t.Run("given notes with dash words when start run then keeps them in the notes", func(t *testing.T) {
+		c, fake, _, _ := newTestCLI()
+
+		if code := c.Run(ctx, []string{"start", "mobile/dev", "fix", "-v", "flag", "--output", "json"}); code != ExitOK {
+			t.Fatalf("expected exit 0, got %d", code)
+		}
+
+		running, _ := fake.FetchRunningTimeEntryContext(ctx)
+		if running == nil || running.Notes != "fix -v flag" {
+			t.Fatalf("expected notes %q, got %+v", "fix -v flag", running)
+		}
+	})
+
+	t.Run("given notes after a double dash when log run then none of them are parsed as flags", func(t *testing.T) {
+		c, fake, _, _ := newTestCLI()
+
+		code := c.Run(ctx, []string{"log", "1:30", "Acme/Website/Design", "--", "review", "--date", "-o", "table"})
+		if code != ExitOK {
+			t.Fatalf("expected exit 0, got %d", code)
+		}
+
+		entries, _ := fake.FetchTimeEntriesContext(ctx, "2025-01-15")
+		if len(entries) != 1 || entries[0].Notes != "review --date -o table" {
+			t.Fatalf("expected notes %q on today, got %+v", "review --date -o table", entries)
+		}
+	})
+
+	t.Run("given unknown flag before the arguments when start run then exits 2", func(t *testing.T) {
+		c, _, _, _ := newTestCLI()
+
+		if code := c.Run(ctx, []string{"start", "-v", "mobile/dev"}); code != ExitUsage {
+			t.Errorf("expected exit 2, got %d", code)
+		}
+	})
+
+	t.Run("given running timer when stop run then stops it", func(t *testing.T) {
+		c, fake, stdout, _ := newTestCLI()
+		fake.AddTimeEntry(harvest.TimeEntry{ID: 5, SpentDate: "2025-01-14", Hours: 2.25, IsRunning: true,
+			Client:  harvest.TimeEntryClient{Name: "Acme"},
+			Project: harvest.TimeEntryProject{ID: 10, Name: "Website"},
+			Task:    harvest.TimeEntryTask{ID: 100, Name: "Development"},
+		})
+
+		if code := c.Run(ctx, []string{"stop"}); code != ExitOK {
+			t.Fatalf("expected exit 0, got %d", code)
+		}
+		if running, _ := fake.FetchRunningTimeEntryContext(ctx); running != nil {
+			t.Errorf("expected no running timer, got %+v", running)
+		}
+		if stdout.String() != "Stopped timer: Acme → Website → Development  2:15\n" {
+			t.Errorf("unexpected output %q", stdout.String())
+		}
+	})
+
+	t.Run("given no running timer when stop run then exits 1", func(t *testing.T) {
+		c, _, _, stderr := newTestCLI()
+
+		if code := c.Run(ctx, []string{"stop"}); code != ExitError {
+			t.Errorf("expected exit 1, got %d", code)
+		}
+		if stderr.String() != "Error: no timer is running\n" {
+			t.Errorf("unexpected error output %q", stderr.String())
+		}
+	})
+
+	t.Run("given log with date flag after the notes when run then logs to that day", func(t *testing.T) {
+		c, fake, stdout, _ := newTestCLI()
+
+		code := c.Run(ctx, []string{"log", "1:30", "Acme/Website/Design", "Homepage mockups", "--date", "2025-01-13"})
+		if code != ExitOK {
+			t.Fatalf("expected exit 0, got %d", code)
+		}
+
+		entries, _ := fake.FetchTimeEntriesContext(ctx, "2025-01-13")
+		if len(entries) != 1 || entries[0].Hours != 1.5 || entries[0].Task.ID != 101 || entries[0].Notes != "Homepage mockups" {
+			t.Fatalf("expected 1:30 logged to Design, got %+v", entries)
+		}
+		if entries[0].IsRunning {
+			t.Error("expected a stopped entry")
+		}
+		if stdout.String() != "Logged 1:30 to Acme → Website → Design on 2025-01-13\n" {
+			t.Errorf("unexpected output %q", stdout.String())
+		}
+	})
+
+	t.Run("given entries when list run then prints them with a total", func(t *testing.T) {
+		c, fake, stdout, _ := newTestCLI()
+		fake.AddTimeEntry(harvest.TimeEntry{SpentDate: "2025-01-15", Hours: 1.5, Notes: "Standup",
+			Client: harvest.TimeEntryClient{Name: "Acme"}, Project: harvest.TimeEntryProject{Name: "Website"}, Task: harvest.TimeEntryTask{Name: "Development"}})
+		fake.AddTimeEntry(harvest.TimeEntry{SpentDate: "2025-01-15", Hours: 0.25, IsRunning: true,
+			Client: harvest.TimeEntryClient{Name: "Globex"}, Project: harvest.TimeEntryProject{Name: "Mobile App"}, Task: harvest.TimeEntryTask{Name: "Development"}})
+		fake.AddTimeEntry(harvest.TimeEntry{SpentDate: "2025-01-14", Hours: 8})
+
+		if code := c.Run(ctx, []string{"list"}); code != ExitOK {
+			t.Fatalf("expected exit 0, got %d", code)
+		}
+
+		want := "● 0:15  Globex → Mobile App → Development\n" +
+			"  1:30  Acme → Website → Development  Standup\n" +
+			"  1:45  Total\n"
+		if stdout.String() != want {
+			t.Errorf("expected:\n%s\ngot:\n%s", want, stdout.String())
+		}
+	})
+
+	t.Run("given json output when list run then writes the day's entries and totals", func(t *testing.T) {
+		c, fake, stdout, _ := newTestCLI()
+		fake.AddTimeEntry(harvest.TimeEntry{SpentDate: "2025-01-15", Hours: 1.5, IsBillable: true})
+		fake.AddTimeEntry(harvest.TimeEntry{SpentDate: "2025-01-15", Hours: 0.25})
+
+		if code := c.Run(ctx, []string{"list", "--output", "json"}); code != ExitOK {
+			t.Fatalf("expected exit 0, got %d", code)
+		}
+
+		var day export.Day
+		if err := json.Unmarshal(stdout.Bytes(), &day); err != nil {
+			t.Fatalf("expected JSON, got %q: %v", stdout.String(), err)
+		}
+		if day.Date != "2025-01-15" || len(day.Entries) != 2 {
+			t.Errorf("expected two entries on 2025-01-15, got %+v", day)
+		}
+		if day.Totals.Duration != "1:45" || day.Totals.BillableDuration != "1:30" {
+			t.Errorf("expected totals 1:45 and 1:30 billable, got %+v", day.Totals)
+		}
+	})
+
+	t.Run("given no entries when list run as json then writes an empty list", func(t *testing.T) {
+		c, _, stdout, _ := newTestCLI()
+
+		c.Run(ctx, []string{"list", "-o", "json"})
+
+		if !strings.Contains(stdout.String(), `"entries": []`) {
+			t.Errorf("expected empty entries array, got %q", stdout.String())
+		}
+	})
+
+	t.Run("given running timer when status run as json then writes it", func(t *testing.T) {
+		c, fake, stdout, _ := newTestCLI()
+		fake.AddTimeEntry(harvest.TimeEntry{ID: 5, SpentDate: "2025-01-15", Hours: 0.5, IsRunning: true, Notes: "Deploy"})
+
+		c.Run(ctx, []string{"status", "-o", "json"})
+
+		var status export.Status
+		if err := json.Unmarshal(stdout.Bytes(), &status); err != nil {
+			t.Fatalf("expected JSON, got %q: %v", stdout.String(), err)
+		}
+		if status.Running == nil || status.Running.ID != 5 || !status.Running.Running || status.Running.Notes != "Deploy" {
+			t.Errorf("expected running entry 5, got %+v", status.Running)
+		}
+	})
+
+	t.Run("given csv output when log run then writes the new entry as a row", func(t *testing.T) {
+		c, _, stdout, _ := newTestCLI()
+
+		c.Run(ctx, []string{"log", "--output=csv", "0:45", "Mobile/Development"})
+
+		lines := strings.Split(strings.TrimSpace(stdout.String()), "\n")
+		if len(lines) != 2 || !strings.HasPrefix(lines[0], "id,date,") ||
+			!strings.Contains(lines[1], ",2025-01-15,2,Globex,30,Mobile App,100,Development,,0.75,0:45,") {
+			t.Errorf("expected header and entry row, got %q", stdout.String())
+		}
+	})
+
+	t.Run("given invalid arguments when run then exits 2 with a usage hint", func(t *testing.T) {
+		for _, args := range [][]string{
+			{},
+			{"bogus"},
+			{"start"},
+			{"start", "Website"},
+			{"log", "soon", "Mobile/Development"},
+			{"log", "0:00", "Mobile/Development"},
+			{"list", "--date", "yesterday"},
+			{"list", "--when", "2025-01-15"},
+			{"list", "--output", "yaml"},
+			{"status", "extra"},
+		} {
+			c, fake, _, stderr := newTestCLI()
+
+			if code := c.Run(ctx, args); code != ExitUsage {
+				t.Errorf("expected exit 2 for %q, got %d", args, code)
+			}
+			if stderr.Len() == 0 {
+				t.Errorf("expected an error message for %q", args)
+			}
+			if entries, _ := fake.FetchTimeEntriesRangeContext(ctx, "2000-01-01", "2100-01-01"); len(entries) != 0 {
+				t.Errorf("expected nothing logged for %q, got %+v", args, entries)
+			}
+		}
+	})
+
+	t.Run("given Harvest failure when run then exits 1", func(t *testing.T) {
+		c, fake, _, stderr := newTestCLI()
+		fake.SetError(harvest.NewAPIError("failed to fetch time entries", http.StatusServiceUnavailable, ""))
+
+		if code := c.Run(ctx, []string{"list"}); code != ExitError {
+			t.Errorf("expected exit 1, got %d", code)
+		}
+		if !strings.Contains(stderr.String(), "failed to fetch time entries") {
+			t.Errorf("expected Harvest error reported, got %q", stderr.String())
+		}
+	})
+}
+
+func TestFindProjectTask(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("given unique part of names when matched then finds the project and task", func(t *testing.T) {
+		c, _, _, _ := newTestCLI()
+
+		project, task, err := c.findProjectTask(ctx, "MOBILE/devel")
+
+		if err != nil || project.ID != 30 || task.ID != 100 {
+			t.Errorf("expected Mobile App/Development, got %+v %+v %v", project, task, err)
+		}
+	})
+
+	t.Run("given project name shared by two clients when matched then asks for the client", func(t *testing.T) {
+		c, _, _, _ := newTestCLI()
+
+		_, _, err := c.findProjectTask(ctx, "Website/Development")
+		if err == nil || err.Error() != `project "website" is ambiguous: Acme/Website, Globex/Website` {
+			t.Errorf("expected ambiguity error, got %v", err)
+		}
+
+		project, _, err := c.findProjectTask(ctx, "globex/website/development")
+		if err != nil || project.ID != 20 {
+			t.Errorf("expected Globex's Website, got %+v %v", project, err)
+		}
+	})
+
+	t.Run("given exact task name that is also part of another when matched then prefers the exact one", func(t *testing.T) {
+		c, fake, _, _ := newTestCLI()
+		fake.AddProject(
+			harvest.Project{ID: 40, Name: "Support", Client: harvest.ProjectClient{ID: 3, Name: "Initech"}},
+			harvest.Task{ID: 200, Name: "Design"},
+			harvest.Task{ID: 201, Name: "Design Review"},
+		)
+
+		_, task, err := c.findProjectTask(ctx, "support/design")
+
+		if err != nil || task.ID != 200 {
+			t.Errorf("expected Design, got %+v %v", task, err)
+		}
+	})
+
+	t.Run("given unknown task when matched then returns a usage error", func(t *testing.T) {
+		c, _, _, _ := newTestCLI()
+
+		_, _, err := c.findProjectTask(ctx, "Mobile/Meetings")
+
+		if _, ok := err.(usageError); !ok || err.Error() != `no task matches "meetings"` {
+			t.Errorf("expected no match usage error, got %v", err)
+		}
+	})
+}
@@ -0,0 +1,65 @@
+package cli
+
+import (
+	"context"
+	"flag"
+	"strings"
+	"time"
+
+	"github.com/planetargon/harvest-tui/internal/export"
+)
+
+// WithExportDefaults sets the columns and rounding export uses without
+// --columns, --round-to and --round-mode. Empty columns keep the defaults.
+func (c *CLI) WithExportDefaults(columns []string, rounding export.Rounding) *CLI {
+	if len(columns) > 0 {
+		c.exportColumns = columns
+	}
+	c.rounding = rounding
+	return c
+}
+
+// exportCSV writes the entries of a date range as CSV for invoicing reviews.
+func (c *CLI) exportCSV(ctx context.Context, args []string) error {
+	today := c.now()
+	fs := flag.NewFlagSet("export", flag.ContinueOnError)
+	from := fs.String("from", time.Date(today.Year(), today.Month(), 1, 0, 0, 0, 0, today.Location()).Format("2006-01-02"), "first day as YYYY-MM-DD")
+	to := fs.String("to", today.Format("2006-01-02"), "last day as YYYY-MM-DD")
+	columnList := fs.String("columns", strings.Join(c.exportColumns, ","), "comma-separated columns")
+	roundTo := fs.Duration("round-to", c.rounding.Increment, "round hours to a multiple of this")
+	roundMode := fs.String("round-mode", string(c.rounding.Mode), "nearest, up or down")
+	args, err := parseArgs(fs, args)
+	if err != nil {
+		return err
+	}
+	if len(args) > 0 {
+		return usagef("export takes no arguments besides its flags")
+	}
+	if err := validateDate(*from); err != nil {
+		return err
+	}
+	if err := validateDate(*to); err != nil {
+		return err
+	}
+	if *to < *from {
+		return usagef("--to %s is before --from %s", *to, *from)
+	}
+	columns, err := export.ParseColumns(*columnList)
+	if err != nil {
+		return usageError{msg: err.Error()}
+	}
+	mode, err := export.ParseRoundingMode(*roundMode)
+	if err != nil {
+		return usageError{msg: err.Error()}
+	}
+	if *roundTo < 0 || *roundTo%time.Minute != 0 {
+		return usagef("--round-to must be a whole number of minutes like 15m")
+	}
+
+	entries, err := c.client.FetchTimeEntriesRangeContext(ctx, *from, *to)
+	if err != nil {
+		return err
+	}
+	report := export.Report(entries, export.Rounding{Increment: *roundTo, Mode: mode})
+	return export.WriteCSVColumns(c.stdout, report, columns)
+}
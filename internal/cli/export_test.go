@@ -0,0 +1,112 @@
+package cli
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/planetargon/harvest-tui/internal/export"
+	"github.com/planetargon/harvest-tui/internal/harvest"
+	"github.com/planetargon/harvest-tui/internal/harvest/harvestfake"
+)
+
+// addMonthEntries adds entries on the 2nd, 10th and 15th of January 2025 and
+// one on the last day of December.
+func addMonthEntries(fake *harvestfake.Client) {
+	entry := func(date string, hours float64, notes string, billable bool) harvest.TimeEntry {
+		return harvest.TimeEntry{
+			SpentDate:  date,
+			Hours:      hours,
+			Notes:      notes,
+			IsBillable: billable,
+			Client:     harvest.TimeEntryClient{ID: 1, Name: "Acme"},
+			Project:    harvest.TimeEntryProject{ID: 10, Name: "Website"},
+			Task:       harvest.TimeEntryTask{ID: 100, Name: "Development"},
+		}
+	}
+	fake.AddTimeEntry(entry("2024-12-31", 1, "Last year", true))
+	fake.AddTimeEntry(entry("2025-01-10", 1.1, "Review", true))
+	fake.AddTimeEntry(entry("2025-01-02", 2, "Kickoff, \"planning\"", true))
+	fake.AddTimeEntry(entry("2025-01-15", 0.3, "", false))
+}
+
+func TestExport(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("given no range when export run then writes this month oldest first with the default columns", func(t *testing.T) {
+		c, fake, stdout, _ := newTestCLI()
+		addMonthEntries(fake)
+
+		if code := c.Run(ctx, []string{"export"}); code != ExitOK {
+			t.Fatalf("expected exit 0, got %d", code)
+		}
+
+		want := "date,client,project,task,notes,hours,duration,billable,locked\n" +
+			"2025-01-02,Acme,Website,Development,\"Kickoff, \"\"planning\"\"\",2.00,2:00,true,false\n" +
+			"2025-01-10,Acme,Website,Development,Review,1.10,1:06,true,false\n" +
+			"2025-01-15,Acme,Website,Development,,0.30,0:18,false,false\n"
+		if stdout.String() != want {
+			t.Errorf("expected:\n%s\ngot:\n%s", want, stdout.String())
+		}
+	})
+
+	t.Run("given range, columns and rounding when export run then applies them", func(t *testing.T) {
+		c, fake, stdout, _ := newTestCLI()
+		addMonthEntries(fake)
+
+		code := c.Run(ctx, []string{"export", "--from", "2024-12-31", "--to", "2025-01-10",
+			"--columns", "date,hours,duration", "--round-to", "15m", "--round-mode", "up"})
+		if code != ExitOK {
+			t.Fatalf("expected exit 0, got %d", code)
+		}
+
+		want := "date,hours,duration\n2024-12-31,1.00,1:00\n2025-01-02,2.00,2:00\n2025-01-10,1.25,1:15\n"
+		if stdout.String() != want {
+			t.Errorf("expected:\n%s\ngot:\n%s", want, stdout.String())
+		}
+	})
+
+	t.Run("given export defaults when export run then uses them", func(t *testing.T) {
+		c, fake, stdout, _ := newTestCLI()
+		addMonthEntries(fake)
+		c.WithExportDefaults([]string{"notes", "hours"}, export.Rounding{Increment: 30 * time.Minute, Mode: export.RoundDown})
+
+		c.Run(ctx, []string{"export", "--from", "2025-01-15"})
+
+		if stdout.String() != "notes,hours\n,0.00\n" {
+			t.Errorf("unexpected output %q", stdout.String())
+		}
+	})
+
+	t.Run("given empty range when export run then writes only the header", func(t *testing.T) {
+		c, _, stdout, _ := newTestCLI()
+
+		if code := c.Run(ctx, []string{"export", "--columns", "date,hours"}); code != ExitOK {
+			t.Fatalf("expected exit 0, got %d", code)
+		}
+
+		if stdout.String() != "date,hours\n" {
+			t.Errorf("expected only the header, got %q", stdout.String())
+		}
+	})
+
+	t.Run("given invalid flags when export run then exits 2", func(t *testing.T) {
+		for _, args := range [][]string{
+			{"export", "--from", "2025-01-15", "--to", "2025-01-01"},
+			{"export", "--from", "January"},
+			{"export", "--columns", "date,rate"},
+			{"export", "--round-to", "90s"},
+			{"export", "--round-mode", "sideways"},
+			{"export", "extra"},
+		} {
+			c, _, stdout, stderr := newTestCLI()
+
+			if code := c.Run(ctx, args); code != ExitUsage {
+				t.Errorf("%v: expected exit 2, got %d", args, code)
+			}
+			if stdout.Len() != 0 || stderr.Len() == 0 {
+				t.Errorf("%v: expected only an error, got %q and %q", args, stdout.String(), stderr.String())
+			}
+		}
+	})
+}
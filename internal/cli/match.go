@@ -0,0 +1,23 @@
+package cli
+
+import (
+	"context"
+
+	"github.com/planetargon/harvest-tui/internal/harvest"
+)
+
+// findProjectTask resolves a "project/task" or "client/project/task" argument
+// against the user's assigned projects.
+func (c *CLI) findProjectTask(ctx context.Context, arg string) (harvest.Project, harvest.Task, error) {
+	assignments, err := c.client.FetchProjectAssignmentsContext(ctx)
+	if err != nil {
+		return harvest.Project{}, harvest.Task{}, err
+	}
+	projects := harvest.AggregateProjectsWithTasks(harvest.SplitProjectAssignments(assignments))
+
+	project, task, err := harvest.FindProjectTask(projects, arg)
+	if err != nil {
+		return harvest.Project{}, harvest.Task{}, usageError{msg: err.Error()}
+	}
+	return project, task, nil
+}
@@ -0,0 +1,104 @@
+package cli
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/planetargon/harvest-tui/internal/export"
+	"github.com/planetargon/harvest-tui/internal/harvest"
+	"github.com/planetargon/harvest-tui/internal/state"
+)
+
+// defaultPromptFormat is the prompt template used without --format.
+const defaultPromptFormat = "{client} › {task} {elapsed}"
+
+// promptFetchTimeout caps how long prompt waits on Harvest, so a slow or
+// missing connection never freezes a shell prompt.
+const promptFetchTimeout = 2 * time.Second
+
+// WithRunningCache makes prompt answer from a cache of the running timer for
+// accountID while it is younger than refreshInterval, and makes start and
+// stop keep that cache up to date.
+func (c *CLI) WithRunningCache(accountID string, refreshInterval time.Duration) *CLI {
+	c.accountID = accountID
+	c.refreshInterval = refreshInterval
+	return c
+}
+
+// prompt prints the running timer through a template for shell prompts and
+// status lines, and nothing when no timer is running.
+func (c *CLI) prompt(ctx context.Context, args []string) error {
+	fs := flag.NewFlagSet("prompt", flag.ContinueOnError)
+	format := fs.String("format", defaultPromptFormat, "template with {client}, {project}, {task}, {notes}, {elapsed}, {hours} and {date}")
+	args, err := parseArgs(fs, args)
+	if err != nil {
+		return err
+	}
+	if len(args) > 0 {
+		return usagef("prompt takes no arguments besides --format")
+	}
+
+	entry, fetchedAt, err := c.cachedRunningEntry(ctx)
+	if err != nil {
+		return err
+	}
+	if entry == nil {
+		return nil
+	}
+
+	// The cached hours were Harvest's as of the fetch, so count on from there
+	hours := entry.Hours + max(c.now().Sub(fetchedAt), 0).Hours()
+	replacer := strings.NewReplacer(
+		"{client}", entry.Client.Name,
+		"{project}", entry.Project.Name,
+		"{task}", entry.Task.Name,
+		"{notes}", entry.Notes,
+		"{elapsed}", export.FormatDuration(hours),
+		"{hours}", fmt.Sprintf("%.2f", hours),
+		"{date}", entry.SpentDate,
+	)
+	fmt.Fprintln(c.stdout, replacer.Replace(*format))
+	return nil
+}
+
+// cachedRunningEntry returns the running timer from the cache while it was
+// checked within the refresh interval, and fetches and caches it otherwise. A
+// failed fetch falls back to an older cache and counts as a check, so prompts
+// stay quiet and quick offline.
+func (c *CLI) cachedRunningEntry(ctx context.Context) (*harvest.TimeEntry, time.Time, error) {
+	var cache *state.RunningCache
+	if c.accountID != "" {
+		// An unreadable cache is just refetched and overwritten
+		cache, _ = state.LoadRunningCache(c.accountID)
+		if cache != nil && c.now().Sub(cache.LastChecked()) < c.refreshInterval {
+			return cache.Entry, cache.FetchedAt, nil
+		}
+	}
+
+	fetchedAt := c.now()
+	ctx, cancel := context.WithTimeout(ctx, promptFetchTimeout)
+	defer cancel()
+	entry, err := c.client.FetchRunningTimeEntryContext(ctx)
+	if err != nil {
+		if cache != nil {
+			cache.CheckedAt = fetchedAt
+			_ = state.WriteRunningCache(*cache)
+			return cache.Entry, cache.FetchedAt, nil
+		}
+		return nil, time.Time{}, err
+	}
+	c.saveRunningCache(entry, fetchedAt)
+	return entry, fetchedAt, nil
+}
+
+// saveRunningCache records the running timer, or nil for none, for prompt.
+// The cache is only a shortcut, so failing to write it is not an error.
+func (c *CLI) saveRunningCache(entry *harvest.TimeEntry, fetchedAt time.Time) {
+	if c.accountID == "" {
+		return
+	}
+	_ = state.SaveRunningCache(c.accountID, entry, fetchedAt)
+}
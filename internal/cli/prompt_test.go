@@ -0,0 +1,198 @@
+package cli
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/planetargon/harvest-tui/internal/harvest"
+	"github.com/planetargon/harvest-tui/internal/harvest/harvestfake"
+	"github.com/planetargon/harvest-tui/internal/state"
+)
+
+// countingAPI counts the requests for the running timer.
+type countingAPI struct {
+	*harvestfake.Client
+	runningFetches int
+}
+
+func (a *countingAPI) FetchRunningTimeEntryContext(ctx context.Context) (*harvest.TimeEntry, error) {
+	a.runningFetches++
+	return a.Client.FetchRunningTimeEntryContext(ctx)
+}
+
+// newPromptCLI returns a test CLI that caches the running timer for a minute,
+// with the clock under the test's control.
+func newPromptCLI(t *testing.T) (*CLI, *countingAPI, *bytes.Buffer, *time.Time) {
+	t.Helper()
+	t.Setenv("HOME", t.TempDir())
+	c, fake, stdout, _ := newTestCLI()
+	api := &countingAPI{Client: fake}
+	c.client = api
+	c.WithRunningCache("12345", time.Minute)
+
+	now := time.Date(2025, 1, 15, 9, 0, 0, 0, time.Local)
+	c.now = func() time.Time { return now }
+	return c, api, stdout, &now
+}
+
+func runningTimer() harvest.TimeEntry {
+	return harvest.TimeEntry{
+		ID:        5,
+		SpentDate: "2025-01-15",
+		Hours:     1.5,
+		Notes:     "Login bug",
+		IsRunning: true,
+		Client:    harvest.TimeEntryClient{ID: 1, Name: "Acme"},
+		Project:   harvest.TimeEntryProject{ID: 10, Name: "Website"},
+		Task:      harvest.TimeEntryTask{ID: 100, Name: "Development"},
+	}
+}
+
+func TestPrompt(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("given running timer when prompt run then prints it with the default format", func(t *testing.T) {
+		c, api, stdout, _ := newPromptCLI(t)
+		api.AddTimeEntry(runningTimer())
+
+		if code := c.Run(ctx, []string{"prompt"}); code != ExitOK {
+			t.Fatalf("expected exit 0, got %d", code)
+		}
+
+		if stdout.String() != "Acme › Development 1:30\n" {
+			t.Errorf("unexpected prompt %q", stdout.String())
+		}
+	})
+
+	t.Run("given format when prompt run then fills in every placeholder", func(t *testing.T) {
+		c, api, stdout, _ := newPromptCLI(t)
+		api.AddTimeEntry(runningTimer())
+
+		c.Run(ctx, []string{"prompt", "--format", "#[fg=green]{project}/{task} ({notes}) {hours}h {date}"})
+
+		if stdout.String() != "#[fg=green]Website/Development (Login bug) 1.50h 2025-01-15\n" {
+			t.Errorf("unexpected prompt %q", stdout.String())
+		}
+	})
+
+	t.Run("given no running timer when prompt run then prints nothing", func(t *testing.T) {
+		c, _, stdout, _ := newPromptCLI(t)
+
+		if code := c.Run(ctx, []string{"prompt"}); code != ExitOK {
+			t.Fatalf("expected exit 0, got %d", code)
+		}
+
+		if stdout.String() != "" {
+			t.Errorf("expected no output, got %q", stdout.String())
+		}
+	})
+
+	t.Run("given fresh cache when prompt run again then counts on without asking Harvest", func(t *testing.T) {
+		c, api, stdout, now := newPromptCLI(t)
+		api.AddTimeEntry(runningTimer())
+		c.Run(ctx, []string{"prompt"})
+		stdout.Reset()
+
+		*now = now.Add(30 * time.Second)
+		c.Run(ctx, []string{"prompt", "--format", "{elapsed}"})
+
+		if api.runningFetches != 1 {
+			t.Errorf("expected one request within the interval, got %d", api.runningFetches)
+		}
+		if got := stdout.String(); got != "1:31\n" {
+			t.Errorf("expected elapsed time counted from the cache, got %q", got)
+		}
+	})
+
+	t.Run("given cache older than the interval when prompt run then asks Harvest again", func(t *testing.T) {
+		c, api, _, now := newPromptCLI(t)
+		c.Run(ctx, []string{"prompt"})
+
+		*now = now.Add(2 * time.Minute)
+		c.Run(ctx, []string{"prompt"})
+
+		if api.runningFetches != 2 {
+			t.Errorf("expected a second request after the interval, got %d", api.runningFetches)
+		}
+	})
+
+	t.Run("given stale cache when Harvest fails then prints the cached timer", func(t *testing.T) {
+		c, api, stdout, now := newPromptCLI(t)
+		timer := runningTimer()
+		if err := state.SaveRunningCache("12345", &timer, now.Add(-time.Hour)); err != nil {
+			t.Fatal(err)
+		}
+		api.SetError(harvest.NewAPIError("failed to fetch time entries", http.StatusServiceUnavailable, ""))
+
+		if code := c.Run(ctx, []string{"prompt", "--format", "{task} {elapsed}"}); code != ExitOK {
+			t.Fatalf("expected exit 0, got %d", code)
+		}
+
+		if stdout.String() != "Development 2:30\n" {
+			t.Errorf("expected cached timer, got %q", stdout.String())
+		}
+	})
+
+	t.Run("given cached timer when stop run then prompt shows nothing without asking Harvest", func(t *testing.T) {
+		c, api, stdout, _ := newPromptCLI(t)
+		api.AddTimeEntry(runningTimer())
+		c.Run(ctx, []string{"prompt"})
+		c.Run(ctx, []string{"stop"})
+		stdout.Reset()
+		fetches := api.runningFetches
+
+		c.Run(ctx, []string{"prompt"})
+
+		if api.runningFetches != fetches {
+			t.Error("expected the prompt to use the cache stop updated")
+		}
+		if got := stdout.String(); got != "" {
+			t.Errorf("expected no output, got %q", got)
+		}
+	})
+
+	t.Run("given stale cache when Harvest fails then waits the interval before asking again", func(t *testing.T) {
+		c, api, _, now := newPromptCLI(t)
+		timer := runningTimer()
+		if err := state.SaveRunningCache("12345", &timer, now.Add(-time.Hour)); err != nil {
+			t.Fatal(err)
+		}
+		api.SetError(harvest.NewAPIError("failed to fetch time entries", http.StatusServiceUnavailable, ""))
+
+		c.Run(ctx, []string{"prompt"})
+		*now = now.Add(30 * time.Second)
+		c.Run(ctx, []string{"prompt"})
+
+		if api.runningFetches != 1 {
+			t.Errorf("expected the failed request to count as a check, got %d requests", api.runningFetches)
+		}
+	})
+
+	t.Run("given slow Harvest when prompt run then gives up after the timeout", func(t *testing.T) {
+		c, api, _, _ := newPromptCLI(t)
+		c.client = &slowAPI{countingAPI: api}
+
+		start := time.Now()
+		code := c.Run(ctx, []string{"prompt"})
+
+		if code != ExitError {
+			t.Errorf("expected exit 1 without a cache, got %d", code)
+		}
+		if elapsed := time.Since(start); elapsed > promptFetchTimeout+time.Second {
+			t.Errorf("expected prompt to give up after %v, took %v", promptFetchTimeout, elapsed)
+		}
+	})
+}
+
+// slowAPI never answers for the running timer until the request is cancelled.
+type slowAPI struct {
+	*countingAPI
+}
+
+func (a *slowAPI) FetchRunningTimeEntryContext(ctx context.Context) (*harvest.TimeEntry, error) {
+	<-ctx.Done()
+	return nil, ctx.Err()
+}
@@ -4,14 +4,22 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"slices"
+	"strings"
+	"time"
 
 	"github.com/BurntSushi/toml"
+	"github.com/planetargon/harvest-tui/internal/export"
 )
 
 const SetupInstructionsURL = "https://github.com/planetargon/harvest-tui?tab=readme-ov-file#getting-harvest-api-credentials"
 
 type Config struct {
 	Harvest HarvestConfig `toml:"harvest"`
+	Retry   RetryConfig   `toml:"retry"`
+	Timer   TimerConfig   `toml:"timer"`
+	Prompt  PromptConfig  `toml:"prompt"`
+	Export  ExportConfig  `toml:"export"`
 }
 
 type HarvestConfig struct {
@@ -19,6 +27,96 @@ type HarvestConfig struct {
 	AccessToken string `toml:"access_token"`
 }
 
+// RetryConfig controls retrying of rate-limited (429) and transient (5xx) Harvest API failures.
+// Set max_retries to 0 to disable retrying.
+type RetryConfig struct {
+	MaxRetries int           `toml:"max_retries"`
+	BaseDelay  time.Duration `toml:"base_delay"`
+	MaxDelay   time.Duration `toml:"max_delay"`
+}
+
+// DefaultRetryConfig returns the retry settings used when the config file has no [retry] section.
+func DefaultRetryConfig() RetryConfig {
+	return RetryConfig{
+		MaxRetries: 3,
+		BaseDelay:  500 * time.Millisecond,
+		MaxDelay:   30 * time.Second,
+	}
+}
+
+// TimerConfig controls when a running timer is treated as forgotten and the user is asked about it.
+// Set max_running to 0 to disable the duration check; end_of_day is off unless set (e.g. "18:00").
+type TimerConfig struct {
+	MaxRunning time.Duration `toml:"max_running"`
+	EndOfDay   string        `toml:"end_of_day"`
+}
+
+// DefaultTimerConfig returns the timer settings used when the config file has no [timer] section.
+func DefaultTimerConfig() TimerConfig {
+	return TimerConfig{
+		MaxRunning: 8 * time.Hour,
+	}
+}
+
+// PromptConfig controls "harvest-tui prompt", which shows the running timer in shell prompts and status lines.
+// It asks Harvest for the running timer at most once per refresh_interval and answers from a cache in between.
+type PromptConfig struct {
+	RefreshInterval time.Duration `toml:"refresh_interval"`
+}
+
+// DefaultPromptConfig returns the prompt settings used when the config file has no [prompt] section.
+func DefaultPromptConfig() PromptConfig {
+	return PromptConfig{
+		RefreshInterval: 30 * time.Second,
+	}
+}
+
+// ExportConfig controls CSV exports of a date range from "harvest-tui export" and the app.
+// round_to rounds each entry's hours to a multiple of it, in the round_mode direction; 0 turns rounding off.
+// directory is where the app saves exports, the working directory when empty.
+type ExportConfig struct {
+	Columns   []string      `toml:"columns"`
+	RoundTo   time.Duration `toml:"round_to"`
+	RoundMode string        `toml:"round_mode"`
+	Directory string        `toml:"directory"`
+}
+
+// DefaultExportConfig returns the export settings used when the config file has no [export] section.
+func DefaultExportConfig() ExportConfig {
+	return ExportConfig{
+		Columns:   slices.Clone(export.DefaultColumns),
+		RoundMode: string(export.RoundNearest),
+	}
+}
+
+// Rounding returns the rounding applied to exported hours.
+func (e ExportConfig) Rounding() export.Rounding {
+	return export.Rounding{Increment: e.RoundTo, Mode: export.RoundingMode(e.RoundMode)}
+}
+
+// timeOfDayLayouts are the accepted formats for end_of_day.
+var timeOfDayLayouts = []string{"15:04", "3:04pm", "3pm"}
+
+// EndOfDayOn returns the configured end of day on date's day, or false when end_of_day is not set.
+func (t TimerConfig) EndOfDayOn(date time.Time) (time.Time, bool) {
+	clock, err := parseTimeOfDay(t.EndOfDay)
+	if t.EndOfDay == "" || err != nil {
+		return time.Time{}, false
+	}
+	year, month, day := date.Date()
+	return time.Date(year, month, day, clock.Hour(), clock.Minute(), 0, 0, date.Location()), true
+}
+
+func parseTimeOfDay(s string) (time.Time, error) {
+	s = strings.ToLower(strings.ReplaceAll(s, " ", ""))
+	for _, layout := range timeOfDayLayouts {
+		if clock, err := time.Parse(layout, s); err == nil {
+			return clock, nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("invalid time of day %q", s)
+}
+
 func Load() (*Config, error) {
 	configPath, err := getConfigPath()
 	if err != nil {
@@ -29,7 +127,12 @@ func Load() (*Config, error) {
 		return nil, fmt.Errorf("could not load config file. Create %s with your Harvest credentials.\n\nTo get started, set up your Harvest API credentials:\n%s", configPath, SetupInstructionsURL)
 	}
 
-	var config Config
+	config := Config{
+		Retry:  DefaultRetryConfig(),
+		Timer:  DefaultTimerConfig(),
+		Prompt: DefaultPromptConfig(),
+		Export: DefaultExportConfig(),
+	}
 	if _, err := toml.DecodeFile(configPath, &config); err != nil {
 		return nil, fmt.Errorf("could not parse config file: %w", err)
 	}
@@ -48,6 +151,34 @@ func (c *Config) Validate() error {
 	if c.Harvest.AccessToken == "" {
 		return fmt.Errorf("access_token is required.\n\nTo get started, set up your Harvest API credentials:\n%s", SetupInstructionsURL)
 	}
+	if c.Retry.MaxRetries < 0 {
+		return fmt.Errorf("retry.max_retries cannot be negative")
+	}
+	if c.Retry.BaseDelay < 0 || c.Retry.MaxDelay < 0 {
+		return fmt.Errorf("retry delays cannot be negative")
+	}
+	if c.Timer.MaxRunning < 0 {
+		return fmt.Errorf("timer.max_running cannot be negative")
+	}
+	if c.Prompt.RefreshInterval < 0 {
+		return fmt.Errorf("prompt.refresh_interval cannot be negative")
+	}
+	if c.Export.RoundTo < 0 || c.Export.RoundTo%time.Minute != 0 {
+		return fmt.Errorf("export.round_to must be a whole number of minutes like \"15m\"")
+	}
+	if _, err := export.ParseRoundingMode(c.Export.RoundMode); err != nil {
+		return fmt.Errorf("export.round_mode: %w", err)
+	}
+	if c.Export.Columns != nil {
+		if err := export.ValidateColumns(c.Export.Columns); err != nil {
+			return fmt.Errorf("export.columns: %w", err)
+		}
+	}
+	if c.Timer.EndOfDay != "" {
+		if _, err := parseTimeOfDay(c.Timer.EndOfDay); err != nil {
+			return fmt.Errorf("timer.end_of_day must be a time of day like \"18:00\" or \"6pm\"")
+		}
+	}
 	return nil
 }
 
@@ -3,7 +3,9 @@ package config
 import (
 	"os"
 	"path/filepath"
+	"strings"
 	"testing"
+	"time"
 )
 
 func TestConfig(t *testing.T) {
@@ -186,4 +188,302 @@ setting = "value"
 			t.Errorf("expected account_id required error, got '%s'", err.Error())
 		}
 	})
+
+	t.Run("given config without retry section when loaded then uses default retry settings", func(t *testing.T) {
+		tempDir := t.TempDir()
+		originalHome := os.Getenv("HOME")
+		t.Cleanup(func() { os.Setenv("HOME", originalHome) })
+
+		os.Setenv("HOME", tempDir)
+
+		configDir := filepath.Join(tempDir, ".config", "harvest-tui")
+		if err := os.MkdirAll(configDir, 0755); err != nil {
+			t.Fatal(err)
+		}
+
+		content := `[harvest]
+account_id = "12345"
+access_token = "abc123"
+`
+		if err := os.WriteFile(filepath.Join(configDir, "config.toml"), []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+
+		config, err := Load()
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+
+		if config.Retry != DefaultRetryConfig() {
+			t.Errorf("expected default retry config, got %+v", config.Retry)
+		}
+	})
+
+	t.Run("given config with retry section when loaded then overrides retry settings", func(t *testing.T) {
+		tempDir := t.TempDir()
+		originalHome := os.Getenv("HOME")
+		t.Cleanup(func() { os.Setenv("HOME", originalHome) })
+
+		os.Setenv("HOME", tempDir)
+
+		configDir := filepath.Join(tempDir, ".config", "harvest-tui")
+		if err := os.MkdirAll(configDir, 0755); err != nil {
+			t.Fatal(err)
+		}
+
+		content := `[harvest]
+account_id = "12345"
+access_token = "abc123"
+
+[retry]
+max_retries = 0
+base_delay = "250ms"
+max_delay = "1m"
+`
+		if err := os.WriteFile(filepath.Join(configDir, "config.toml"), []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+
+		config, err := Load()
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+
+		if config.Retry.MaxRetries != 0 {
+			t.Errorf("expected max_retries 0, got %d", config.Retry.MaxRetries)
+		}
+		if config.Retry.BaseDelay != 250*time.Millisecond {
+			t.Errorf("expected base_delay 250ms, got %v", config.Retry.BaseDelay)
+		}
+		if config.Retry.MaxDelay != time.Minute {
+			t.Errorf("expected max_delay 1m, got %v", config.Retry.MaxDelay)
+		}
+	})
+
+	t.Run("given negative retry settings when validated then returns error", func(t *testing.T) {
+		config := &Config{
+			Harvest: HarvestConfig{AccountID: "12345", AccessToken: "abc123def456"},
+			Retry:   RetryConfig{MaxRetries: -1},
+		}
+
+		if err := config.Validate(); err == nil || err.Error() != "retry.max_retries cannot be negative" {
+			t.Errorf("expected max_retries error, got %v", err)
+		}
+
+		config.Retry = RetryConfig{BaseDelay: -time.Second}
+		if err := config.Validate(); err == nil || err.Error() != "retry delays cannot be negative" {
+			t.Errorf("expected delay error, got %v", err)
+		}
+	})
+
+	t.Run("given config without timer section when loaded then uses default timer settings", func(t *testing.T) {
+		tempDir := t.TempDir()
+		t.Setenv("HOME", tempDir)
+
+		configDir := filepath.Join(tempDir, ".config", "harvest-tui")
+		if err := os.MkdirAll(configDir, 0755); err != nil {
+			t.Fatal(err)
+		}
+
+		content := `[harvest]
+account_id = "12345"
+access_token = "abc123"
+`
+		if err := os.WriteFile(filepath.Join(configDir, "config.toml"), []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+
+		config, err := Load()
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+
+		if config.Timer != DefaultTimerConfig() {
+			t.Errorf("expected default timer config, got %+v", config.Timer)
+		}
+		if _, ok := config.Timer.EndOfDayOn(time.Now()); ok {
+			t.Error("expected no end of day by default")
+		}
+	})
+
+	t.Run("given config with timer section when loaded then overrides timer settings", func(t *testing.T) {
+		tempDir := t.TempDir()
+		t.Setenv("HOME", tempDir)
+
+		configDir := filepath.Join(tempDir, ".config", "harvest-tui")
+		if err := os.MkdirAll(configDir, 0755); err != nil {
+			t.Fatal(err)
+		}
+
+		content := `[harvest]
+account_id = "12345"
+access_token = "abc123"
+
+[timer]
+max_running = "10h"
+end_of_day = "6:30pm"
+`
+		if err := os.WriteFile(filepath.Join(configDir, "config.toml"), []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+
+		config, err := Load()
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+
+		if config.Timer.MaxRunning != 10*time.Hour {
+			t.Errorf("expected max_running 10h, got %v", config.Timer.MaxRunning)
+		}
+		date := time.Date(2025, 1, 15, 9, 0, 0, 0, time.Local)
+		end, ok := config.Timer.EndOfDayOn(date)
+		if !ok || !end.Equal(time.Date(2025, 1, 15, 18, 30, 0, 0, time.Local)) {
+			t.Errorf("expected end of day 18:30 on Jan 15, got %v (%v)", end, ok)
+		}
+	})
+
+	t.Run("given invalid timer settings when validated then returns error", func(t *testing.T) {
+		config := &Config{
+			Harvest: HarvestConfig{AccountID: "12345", AccessToken: "abc123def456"},
+			Timer:   TimerConfig{MaxRunning: -time.Hour},
+		}
+
+		if err := config.Validate(); err == nil || err.Error() != "timer.max_running cannot be negative" {
+			t.Errorf("expected max_running error, got %v", err)
+		}
+
+		config.Timer = TimerConfig{EndOfDay: "late"}
+		if err := config.Validate(); err == nil || !strings.Contains(err.Error(), "timer.end_of_day") {
+			t.Errorf("expected end_of_day error, got %v", err)
+		}
+	})
+
+	t.Run("given config with prompt section when loaded then overrides the refresh interval", func(t *testing.T) {
+		tempDir := t.TempDir()
+		t.Setenv("HOME", tempDir)
+
+		configDir := filepath.Join(tempDir, ".config", "harvest-tui")
+		if err := os.MkdirAll(configDir, 0755); err != nil {
+			t.Fatal(err)
+		}
+
+		content := `[harvest]
+account_id = "12345"
+access_token = "abc123"
+
+[prompt]
+refresh_interval = "2m"
+`
+		if err := os.WriteFile(filepath.Join(configDir, "config.toml"), []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+
+		config, err := Load()
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+
+		if config.Prompt.RefreshInterval != 2*time.Minute {
+			t.Errorf("expected refresh_interval 2m, got %v", config.Prompt.RefreshInterval)
+		}
+	})
+
+	t.Run("given negative prompt refresh interval when validated then returns error", func(t *testing.T) {
+		config := &Config{
+			Harvest: HarvestConfig{AccountID: "12345", AccessToken: "abc123def456"},
+			Prompt:  PromptConfig{RefreshInterval: -time.Second},
+		}
+
+		if err := config.Validate(); err == nil || err.Error() != "prompt.refresh_interval cannot be negative" {
+			t.Errorf("expected refresh_interval error, got %v", err)
+		}
+	})
+
+	t.Run("given config without export section when loaded then uses the default columns", func(t *testing.T) {
+		tempDir := t.TempDir()
+		t.Setenv("HOME", tempDir)
+
+		configDir := filepath.Join(tempDir, ".config", "harvest-tui")
+		if err := os.MkdirAll(configDir, 0755); err != nil {
+			t.Fatal(err)
+		}
+
+		content := `[harvest]
+account_id = "12345"
+access_token = "abc123"
+`
+		if err := os.WriteFile(filepath.Join(configDir, "config.toml"), []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+
+		config, err := Load()
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+
+		if strings.Join(config.Export.Columns, ",") != "date,client,project,task,notes,hours,duration,billable,locked" {
+			t.Errorf("expected default columns, got %v", config.Export.Columns)
+		}
+		if config.Export.RoundTo != 0 {
+			t.Errorf("expected rounding off, got %v", config.Export.RoundTo)
+		}
+	})
+
+	t.Run("given config with export section when loaded then overrides columns and rounding", func(t *testing.T) {
+		tempDir := t.TempDir()
+		t.Setenv("HOME", tempDir)
+
+		configDir := filepath.Join(tempDir, ".config", "harvest-tui")
+		if err := os.MkdirAll(configDir, 0755); err != nil {
+			t.Fatal(err)
+		}
+
+		content := `[harvest]
+account_id = "12345"
+access_token = "abc123"
+
+[export]
+columns = ["date", "project", "hours"]
+round_to = "15m"
+round_mode = "up"
+directory = "~/Invoices"
+`
+		if err := os.WriteFile(filepath.Join(configDir, "config.toml"), []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+
+		config, err := Load()
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+
+		if strings.Join(config.Export.Columns, ",") != "date,project,hours" {
+			t.Errorf("expected chosen columns, got %v", config.Export.Columns)
+		}
+		if rounding := config.Export.Rounding(); rounding.Increment != 15*time.Minute || rounding.Mode != "up" {
+			t.Errorf("expected 15m rounding up, got %+v", rounding)
+		}
+		if config.Export.Directory != "~/Invoices" {
+			t.Errorf("expected directory, got %q", config.Export.Directory)
+		}
+	})
+
+	t.Run("given invalid export settings when validated then returns error", func(t *testing.T) {
+		config := &Config{Harvest: HarvestConfig{AccountID: "12345", AccessToken: "abc123def456"}}
+
+		config.Export = ExportConfig{Columns: []string{"date", "rate"}}
+		if err := config.Validate(); err == nil || !strings.Contains(err.Error(), `export.columns: unknown column "rate"`) {
+			t.Errorf("expected columns error, got %v", err)
+		}
+
+		config.Export = ExportConfig{RoundTo: 90 * time.Second}
+		if err := config.Validate(); err == nil || !strings.Contains(err.Error(), "export.round_to") {
+			t.Errorf("expected round_to error, got %v", err)
+		}
+
+		config.Export = ExportConfig{RoundMode: "sideways"}
+		if err := config.Validate(); err == nil || !strings.Contains(err.Error(), "export.round_mode") {
+			t.Errorf("expected round_mode error, got %v", err)
+		}
+	})
 }
@@ -0,0 +1,125 @@
+package daemon
+
+import (
+	"context"
+	"time"
+
+	"github.com/planetargon/harvest-tui/internal/harvest"
+)
+
+// The "API" RPC service mirrors harvest.API so the app can run against the
+// daemon's client. These are its arguments and replies.
+
+// DateArgs selects the entries for one day.
+type DateArgs struct {
+	Date string `json:"date"`
+}
+
+// RangeArgs selects the entries between two days, inclusive.
+type RangeArgs struct {
+	From string `json:"from"`
+	To   string `json:"to"`
+}
+
+// ChangesArgs selects the entries between two days changed since a time.
+type ChangesArgs struct {
+	From  string    `json:"from"`
+	To    string    `json:"to"`
+	Since time.Time `json:"since"`
+}
+
+// IDArgs names one time entry.
+type IDArgs struct {
+	ID int `json:"id"`
+}
+
+// UpdateArgs is a change to one time entry.
+type UpdateArgs struct {
+	ID      int                            `json:"id"`
+	Request harvest.UpdateTimeEntryRequest `json:"request"`
+}
+
+// EntryReply is a time entry, or nil where there may be none.
+type EntryReply struct {
+	Entry *harvest.TimeEntry `json:"entry"`
+}
+
+// SessionReply is the account the daemon signed in to, as whom, and the
+// account's settings.
+type SessionReply struct {
+	AccountID string          `json:"account_id"`
+	User      harvest.User    `json:"user"`
+	Company   harvest.Company `json:"company"`
+}
+
+type apiService struct {
+	server *Server
+}
+
+func (a *apiService) Session(_ Empty, reply *SessionReply) error {
+	reply.AccountID = a.server.accountID
+	reply.User = a.server.user
+	reply.Company = a.server.company
+	return nil
+}
+
+func (a *apiService) FetchProjectAssignments(_ Empty, reply *[]harvest.ProjectAssignment) error {
+	assignments, err := a.server.client.FetchProjectAssignmentsContext(context.Background())
+	*reply = assignments
+	return encodeError(err)
+}
+
+func (a *apiService) FetchTimeEntries(args DateArgs, reply *[]harvest.TimeEntry) error {
+	entries, err := a.server.client.FetchTimeEntriesContext(context.Background(), args.Date)
+	*reply = entries
+	return encodeError(err)
+}
+
+func (a *apiService) FetchTimeEntriesRange(args RangeArgs, reply *[]harvest.TimeEntry) error {
+	entries, err := a.server.client.FetchTimeEntriesRangeContext(context.Background(), args.From, args.To)
+	*reply = entries
+	return encodeError(err)
+}
+
+func (a *apiService) FetchTimeEntryChanges(args ChangesArgs, reply *harvest.TimeEntryChanges) error {
+	changes, err := a.server.client.FetchTimeEntryChangesContext(context.Background(), args.From, args.To, args.Since)
+	if err != nil {
+		return encodeError(err)
+	}
+	*reply = *changes
+	return nil
+}
+
+func (a *apiService) FetchRunningTimeEntry(_ Empty, reply *EntryReply) error {
+	entry, err := a.server.client.FetchRunningTimeEntryContext(context.Background())
+	reply.Entry = entry
+	return encodeError(err)
+}
+
+func (a *apiService) CreateTimeEntry(args harvest.CreateTimeEntryRequest, reply *EntryReply) error {
+	entry, err := a.server.client.CreateTimeEntryContext(context.Background(), args)
+	reply.Entry = entry
+	return encodeError(err)
+}
+
+func (a *apiService) UpdateTimeEntry(args UpdateArgs, reply *EntryReply) error {
+	entry, err := a.server.client.UpdateTimeEntryContext(context.Background(), args.ID, args.Request)
+	reply.Entry = entry
+	return encodeError(err)
+}
+
+func (a *apiService) DeleteTimeEntry(args IDArgs, _ *Empty) error {
+	return encodeError(a.server.client.DeleteTimeEntryContext(context.Background(), args.ID))
+}
+
+func (a *apiService) RestartTimeEntry(args IDArgs, reply *EntryReply) error {
+	entry, err := a.server.client.RestartTimeEntryContext(context.Background(), args.ID)
+	reply.Entry = entry
+	return encodeError(err)
+}
+
+func (a *apiService) StopTimeEntry(args IDArgs, reply *EntryReply) error {
+	entry, err := a.server.client.StopTimeEntryContext(context.Background(), args.ID)
+	reply.Entry = entry
+	return encodeError(err)
+}
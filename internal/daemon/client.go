@@ -0,0 +1,140 @@
+package daemon
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"net/rpc"
+	"net/rpc/jsonrpc"
+	"strings"
+	"time"
+
+	"github.com/planetargon/harvest-tui/internal/harvest"
+)
+
+// Client is a harvest.API served by a running daemon.
+type Client struct {
+	rpc *rpc.Client
+}
+
+var _ harvest.API = (*Client)(nil)
+
+// Dial connects to the daemon listening on path. It fails quickly when no
+// daemon is running, so callers can fall back to a client of their own.
+func Dial(path string) (*Client, error) {
+	conn, err := net.DialTimeout("unix", path, time.Second)
+	if err != nil {
+		return nil, err
+	}
+	return &Client{rpc: jsonrpc.NewClient(conn)}, nil
+}
+
+// Close closes the connection to the daemon.
+func (c *Client) Close() error {
+	return c.rpc.Close()
+}
+
+// Session returns the account the daemon signed in to, as whom, and the
+// account's settings.
+func (c *Client) Session(ctx context.Context) (*SessionReply, error) {
+	var reply SessionReply
+	if err := c.call(ctx, "API.Session", Empty{}, &reply); err != nil {
+		return nil, err
+	}
+	return &reply, nil
+}
+
+func (c *Client) FetchProjectAssignmentsContext(ctx context.Context) ([]harvest.ProjectAssignment, error) {
+	var assignments []harvest.ProjectAssignment
+	err := c.call(ctx, "API.FetchProjectAssignments", Empty{}, &assignments)
+	return assignments, err
+}
+
+func (c *Client) FetchTimeEntriesContext(ctx context.Context, date string) ([]harvest.TimeEntry, error) {
+	var entries []harvest.TimeEntry
+	err := c.call(ctx, "API.FetchTimeEntries", DateArgs{Date: date}, &entries)
+	return entries, err
+}
+
+func (c *Client) FetchTimeEntriesRangeContext(ctx context.Context, from, to string) ([]harvest.TimeEntry, error) {
+	var entries []harvest.TimeEntry
+	err := c.call(ctx, "API.FetchTimeEntriesRange", RangeArgs{From: from, To: to}, &entries)
+	return entries, err
+}
+
+func (c *Client) FetchTimeEntryChangesContext(ctx context.Context, from, to string, since time.Time) (*harvest.TimeEntryChanges, error) {
+	var changes harvest.TimeEntryChanges
+	if err := c.call(ctx, "API.FetchTimeEntryChanges", ChangesArgs{From: from, To: to, Since: since}, &changes); err != nil {
+		return nil, err
+	}
+	return &changes, nil
+}
+
+func (c *Client) FetchRunningTimeEntryContext(ctx context.Context) (*harvest.TimeEntry, error) {
+	var reply EntryReply
+	err := c.call(ctx, "API.FetchRunningTimeEntry", Empty{}, &reply)
+	return reply.Entry, err
+}
+
+func (c *Client) CreateTimeEntryContext(ctx context.Context, request harvest.CreateTimeEntryRequest) (*harvest.TimeEntry, error) {
+	var reply EntryReply
+	err := c.call(ctx, "API.CreateTimeEntry", request, &reply)
+	return reply.Entry, err
+}
+
+func (c *Client) UpdateTimeEntryContext(ctx context.Context, id int, request harvest.UpdateTimeEntryRequest) (*harvest.TimeEntry, error) {
+	var reply EntryReply
+	err := c.call(ctx, "API.UpdateTimeEntry", UpdateArgs{ID: id, Request: request}, &reply)
+	return reply.Entry, err
+}
+
+func (c *Client) DeleteTimeEntryContext(ctx context.Context, id int) error {
+	return c.call(ctx, "API.DeleteTimeEntry", IDArgs{ID: id}, &Empty{})
+}
+
+func (c *Client) RestartTimeEntryContext(ctx context.Context, id int) (*harvest.TimeEntry, error) {
+	var reply EntryReply
+	err := c.call(ctx, "API.RestartTimeEntry", IDArgs{ID: id}, &reply)
+	return reply.Entry, err
+}
+
+func (c *Client) StopTimeEntryContext(ctx context.Context, id int) (*harvest.TimeEntry, error) {
+	var reply EntryReply
+	err := c.call(ctx, "API.StopTimeEntry", IDArgs{ID: id}, &reply)
+	return reply.Entry, err
+}
+
+// call makes an RPC, giving up when ctx is done. The daemon still finishes
+// the request, as Harvest would have for a cancelled HTTP call in flight.
+func (c *Client) call(ctx context.Context, method string, args, reply any) error {
+	call := c.rpc.Go(method, args, reply, make(chan *rpc.Call, 1))
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-call.Done:
+		return decodeError(call.Error)
+	}
+}
+
+// decodeError rebuilds the *harvest.APIError the daemon sent, if any.
+func decodeError(err error) error {
+	if errors.Is(err, rpc.ErrShutdown) {
+		return fmt.Errorf("lost connection to the harvest-tui daemon: %w", err)
+	}
+	var serverErr rpc.ServerError
+	if !errors.As(err, &serverErr) || !strings.HasPrefix(string(serverErr), apiErrorPrefix) {
+		return err
+	}
+
+	var wire wireError
+	if jsonErr := json.Unmarshal([]byte(strings.TrimPrefix(string(serverErr), apiErrorPrefix)), &wire); jsonErr != nil {
+		return err
+	}
+	apiErr := harvest.NewAPIError(wire.Summary, wire.StatusCode, wire.Message)
+	apiErr.Method = wire.Method
+	apiErr.Path = wire.Path
+	apiErr.RetryAfter = wire.RetryAfter
+	return apiErr
+}
@@ -0,0 +1,172 @@
+// Package daemon keeps a signed-in Harvest client and its projects in a
+// long-running process and serves them over JSON-RPC on a Unix socket, so
+// editors, scripts and the app itself skip signing in on every start.
+package daemon
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"net/rpc"
+	"net/rpc/jsonrpc"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/planetargon/harvest-tui/internal/harvest"
+)
+
+// projectsTTL is how long the cached projects are trusted for matching names
+// before they are fetched again.
+const projectsTTL = 10 * time.Minute
+
+// apiErrorPrefix marks an RPC error carrying a *harvest.APIError as JSON, so
+// clients can rebuild it and tell failure kinds apart.
+const apiErrorPrefix = "harvest api error: "
+
+// SocketPath returns where the daemon listens, next to the config file.
+func SocketPath() (string, error) {
+	homeDir, err := os.UserHomeDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(homeDir, ".config", "harvest-tui", "daemon.sock"), nil
+}
+
+// Listen opens the daemon socket at path, reachable only by the current user.
+// A socket left behind by a daemon that has exited is replaced; one that is
+// still answering is an error.
+func Listen(path string) (net.Listener, error) {
+	// The socket acts with the user's Harvest token, so nobody else may reach
+	// it, even in the moment before its own permissions are tightened
+	dir := filepath.Dir(path)
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return nil, fmt.Errorf("could not create socket directory: %w", err)
+	}
+	if err := os.Chmod(dir, 0700); err != nil {
+		return nil, fmt.Errorf("could not restrict socket directory permissions: %w", err)
+	}
+	if conn, err := net.DialTimeout("unix", path, time.Second); err == nil {
+		conn.Close()
+		return nil, fmt.Errorf("a daemon is already listening on %s", path)
+	}
+	if err := os.Remove(path); err != nil && !os.IsNotExist(err) {
+		return nil, fmt.Errorf("could not remove stale socket: %w", err)
+	}
+
+	listener, err := net.Listen("unix", path)
+	if err != nil {
+		return nil, fmt.Errorf("could not listen on %s: %w", path, err)
+	}
+	if err := os.Chmod(path, 0600); err != nil {
+		listener.Close()
+		return nil, fmt.Errorf("could not restrict socket permissions: %w", err)
+	}
+	return listener, nil
+}
+
+// Server answers requests from editors and the app with one Harvest client.
+type Server struct {
+	client    harvest.API
+	accountID string
+	user      harvest.User
+	company   harvest.Company
+	now       func() time.Time
+
+	mu                sync.Mutex
+	projects          []harvest.ProjectWithTasks
+	projectsFetchedAt time.Time
+}
+
+// NewServer creates a Server for a client already signed in to accountID.
+// user and company are handed to app clients in place of signing in again.
+func NewServer(client harvest.API, accountID string, user harvest.User, company harvest.Company) *Server {
+	return &Server{client: client, accountID: accountID, user: user, company: company, now: time.Now}
+}
+
+// Serve accepts connections until listener is closed or ctx is done, serving
+// each on its own goroutine. It returns nil once ctx is done.
+func (s *Server) Serve(ctx context.Context, listener net.Listener) error {
+	rpcServer := rpc.NewServer()
+	if err := rpcServer.RegisterName("Harvest", &harvestService{server: s}); err != nil {
+		return err
+	}
+	if err := rpcServer.RegisterName("API", &apiService{server: s}); err != nil {
+		return err
+	}
+
+	stop := context.AfterFunc(ctx, func() { listener.Close() })
+	defer stop()
+
+	for {
+		conn, err := listener.Accept()
+		if err != nil {
+			if ctx.Err() != nil {
+				return nil
+			}
+			return err
+		}
+		go rpcServer.ServeCodec(jsonrpc.NewServerCodec(conn))
+	}
+}
+
+// findProjectTask resolves a "project/task" query against the cached
+// projects, refetching them when they are old or the query matches nothing
+// in them, in case the project was assigned since.
+func (s *Server) findProjectTask(ctx context.Context, query string) (harvest.Project, harvest.Task, error) {
+	s.mu.Lock()
+	projects, fetchedAt := s.projects, s.projectsFetchedAt
+	s.mu.Unlock()
+
+	if projects != nil && s.now().Sub(fetchedAt) < projectsTTL {
+		if project, task, err := harvest.FindProjectTask(projects, query); err == nil {
+			return project, task, nil
+		}
+	}
+
+	assignments, err := s.client.FetchProjectAssignmentsContext(ctx)
+	if err != nil {
+		return harvest.Project{}, harvest.Task{}, err
+	}
+	projects = harvest.AggregateProjectsWithTasks(harvest.SplitProjectAssignments(assignments))
+
+	s.mu.Lock()
+	s.projects, s.projectsFetchedAt = projects, s.now()
+	s.mu.Unlock()
+
+	return harvest.FindProjectTask(projects, query)
+}
+
+// encodeError turns err into one that survives the RPC connection, keeping
+// the details of Harvest API errors.
+func encodeError(err error) error {
+	var apiErr *harvest.APIError
+	if err == nil || !errors.As(err, &apiErr) {
+		return err
+	}
+	data, jsonErr := json.Marshal(wireError{
+		Summary:    apiErr.Summary(),
+		StatusCode: apiErr.StatusCode,
+		Message:    apiErr.Message,
+		Method:     apiErr.Method,
+		Path:       apiErr.Path,
+		RetryAfter: apiErr.RetryAfter,
+	})
+	if jsonErr != nil {
+		return err
+	}
+	return errors.New(apiErrorPrefix + string(data))
+}
+
+// wireError is a *harvest.APIError as sent to clients.
+type wireError struct {
+	Summary    string        `json:"summary"`
+	StatusCode int           `json:"status_code"`
+	Message    string        `json:"message"`
+	Method     string        `json:"method"`
+	Path       string        `json:"path"`
+	RetryAfter time.Duration `json:"retry_after"`
+}
@@ -0,0 +1,444 @@
+package daemon
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"net"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/planetargon/harvest-tui/internal/harvest"
+	"github.com/planetargon/harvest-tui/internal/harvest/harvestfake"
+)
+
+// countingAPI counts the requests for project assignments.
+type countingAPI struct {
+	*harvestfake.Client
+	assignmentFetches int
+}
+
+func (a *countingAPI) FetchProjectAssignmentsContext(ctx context.Context) ([]harvest.ProjectAssignment, error) {
+	a.assignmentFetches++
+	return a.Client.FetchProjectAssignmentsContext(ctx)
+}
+
+// startDaemon serves a fake with two projects on a socket in a temporary
+// directory until the test ends, returning the fake and the socket path.
+func startDaemon(t *testing.T) (*countingAPI, string) {
+	t.Helper()
+	// Unix socket paths are short, so avoid the test name in the directory
+	dir, err := os.MkdirTemp("", "htd")
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { os.RemoveAll(dir) })
+	path := filepath.Join(dir, "daemon.sock")
+
+	fake := harvestfake.New()
+	fake.AddProject(
+		harvest.Project{ID: 10, Name: "Website", Client: harvest.ProjectClient{ID: 1, Name: "Acme"}},
+		harvest.Task{ID: 100, Name: "Development"},
+	)
+	fake.AddProject(
+		harvest.Project{ID: 30, Name: "Mobile App", Client: harvest.ProjectClient{ID: 2, Name: "Globex"}},
+		harvest.Task{ID: 100, Name: "Development"},
+	)
+	api := &countingAPI{Client: fake}
+
+	listener, err := Listen(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	server := NewServer(api, "12345", harvest.User{ID: 1, FirstName: "Ada"}, harvest.Company{Clock: "24h"})
+	server.now = func() time.Time { return time.Date(2025, 1, 15, 9, 0, 0, 0, time.Local) }
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error, 1)
+	go func() { done <- server.Serve(ctx, listener) }()
+	t.Cleanup(func() {
+		cancel()
+		if err := <-done; err != nil {
+			t.Errorf("expected Serve to stop cleanly, got %v", err)
+		}
+	})
+	return api, path
+}
+
+// rawCall sends one JSON-RPC request the way an editor would and returns the
+// decoded result and error.
+func rawCall(t *testing.T, path, method string, params any) (map[string]any, any) {
+	t.Helper()
+	conn, err := net.Dial("unix", path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer conn.Close()
+
+	request, _ := json.Marshal(map[string]any{"method": method, "params": []any{params}, "id": 1})
+	if _, err := conn.Write(append(request, '\n')); err != nil {
+		t.Fatal(err)
+	}
+	var response struct {
+		Result map[string]any `json:"result"`
+		Error  any            `json:"error"`
+	}
+	if err := json.NewDecoder(bufio.NewReader(conn)).Decode(&response); err != nil {
+		t.Fatal(err)
+	}
+	return response.Result, response.Error
+}
+
+func dial(t *testing.T, path string) *Client {
+	t.Helper()
+	client, err := Dial(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { client.Close() })
+	return client
+}
+
+func TestHarvestService(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("given project and notes when Start called then starts a timer today", func(t *testing.T) {
+		api, path := startDaemon(t)
+
+		result, rpcErr := rawCall(t, path, "Harvest.Start", map[string]string{"project": "mobile/dev", "notes": "Fix login"})
+
+		if rpcErr != nil {
+			t.Fatalf("expected no error, got %v", rpcErr)
+		}
+		if result["project"] != "Mobile App" || result["running"] != true || result["date"] != "2025-01-15" {
+			t.Errorf("expected running Mobile App entry, got %v", result)
+		}
+		running, _ := api.FetchRunningTimeEntryContext(ctx)
+		if running == nil || running.Notes != "Fix login" {
+			t.Errorf("expected timer with notes, got %+v", running)
+		}
+	})
+
+	t.Run("given unknown project when Start called then fails with the match error", func(t *testing.T) {
+		_, path := startDaemon(t)
+
+		_, rpcErr := rawCall(t, path, "Harvest.Start", map[string]string{"project": "intranet/dev"})
+
+		if rpcErr != `no project matches "intranet"` {
+			t.Errorf("expected match error, got %v", rpcErr)
+		}
+	})
+
+	t.Run("given running timer when Status called then replies with it", func(t *testing.T) {
+		_, path := startDaemon(t)
+		rawCall(t, path, "Harvest.Start", map[string]string{"project": "website/dev"})
+
+		result, _ := rawCall(t, path, "Harvest.Status", struct{}{})
+
+		running, ok := result["running"].(map[string]any)
+		if !ok || running["project"] != "Website" {
+			t.Errorf("expected running Website entry, got %v", result)
+		}
+	})
+
+	t.Run("given no running timer when Stop called then fails", func(t *testing.T) {
+		_, path := startDaemon(t)
+
+		_, rpcErr := rawCall(t, path, "Harvest.Stop", struct{}{})
+
+		if rpcErr != "no timer is running" {
+			t.Errorf("expected no timer error, got %v", rpcErr)
+		}
+	})
+
+	t.Run("given running timer when Switch called then stops it and starts another", func(t *testing.T) {
+		api, path := startDaemon(t)
+		rawCall(t, path, "Harvest.Start", map[string]string{"project": "website/dev"})
+
+		result, rpcErr := rawCall(t, path, "Harvest.Switch", map[string]string{"project": "mobile/dev"})
+
+		if rpcErr != nil {
+			t.Fatalf("expected no error, got %v", rpcErr)
+		}
+		stopped, _ := result["stopped"].(map[string]any)
+		started, _ := result["started"].(map[string]any)
+		if stopped["project"] != "Website" || stopped["running"] != false || started["project"] != "Mobile App" {
+			t.Errorf("expected Website stopped and Mobile App started, got %v", result)
+		}
+		if entries := api.TimeEntries(); len(entries) != 2 {
+			t.Errorf("expected two entries, got %d", len(entries))
+		}
+	})
+
+	t.Run("given unknown project when Switch called then leaves the running timer alone", func(t *testing.T) {
+		api, path := startDaemon(t)
+		rawCall(t, path, "Harvest.Start", map[string]string{"project": "website/dev"})
+
+		_, rpcErr := rawCall(t, path, "Harvest.Switch", map[string]string{"project": "intranet/dev"})
+
+		if rpcErr == nil {
+			t.Fatal("expected an error")
+		}
+		if running, _ := api.FetchRunningTimeEntryContext(ctx); running == nil || running.Project.ID != 10 {
+			t.Errorf("expected Website timer still running, got %+v", running)
+		}
+	})
+
+	t.Run("given running timer with notes when AppendNote called then adds a line", func(t *testing.T) {
+		api, path := startDaemon(t)
+		rawCall(t, path, "Harvest.Start", map[string]string{"project": "website/dev", "notes": "Login bug"})
+
+		result, rpcErr := rawCall(t, path, "Harvest.AppendNote", map[string]string{"text": "Found the cause"})
+
+		if rpcErr != nil {
+			t.Fatalf("expected no error, got %v", rpcErr)
+		}
+		if result["notes"] != "Login bug\nFound the cause" {
+			t.Errorf("expected appended notes, got %q", result["notes"])
+		}
+		if running, _ := api.FetchRunningTimeEntryContext(ctx); running.Notes != "Login bug\nFound the cause" {
+			t.Errorf("expected notes saved, got %q", running.Notes)
+		}
+	})
+
+	t.Run("given several starts when names match then fetches projects once", func(t *testing.T) {
+		api, path := startDaemon(t)
+
+		rawCall(t, path, "Harvest.Start", map[string]string{"project": "website/dev"})
+		rawCall(t, path, "Harvest.Start", map[string]string{"project": "mobile/dev"})
+
+		if api.assignmentFetches != 1 {
+			t.Errorf("expected cached projects to be reused, got %d fetches", api.assignmentFetches)
+		}
+	})
+}
+
+func TestClient(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("given daemon when session requested then returns its account, user and company", func(t *testing.T) {
+		_, path := startDaemon(t)
+		client := dial(t, path)
+
+		session, err := client.Session(ctx)
+
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		if session.AccountID != "12345" || session.User.FirstName != "Ada" || session.Company.Clock != "24h" {
+			t.Errorf("unexpected session %+v", session)
+		}
+	})
+
+	t.Run("given client when entry created then it reads back through the daemon", func(t *testing.T) {
+		_, path := startDaemon(t)
+		client := dial(t, path)
+
+		created, err := client.CreateTimeEntryContext(ctx, harvest.CreateTimeEntryRequest{
+			ProjectID: 10, TaskID: 100, SpentDate: "2025-01-15", Hours: 1.5, Notes: "Standup",
+		})
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+
+		entries, err := client.FetchTimeEntriesContext(ctx, "2025-01-15")
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		if len(entries) != 1 || entries[0].ID != created.ID || entries[0].Notes != "Standup" {
+			t.Errorf("expected the created entry, got %+v", entries)
+		}
+
+		running, err := client.FetchRunningTimeEntryContext(ctx)
+		if err != nil || running != nil {
+			t.Errorf("expected no running timer, got %+v, %v", running, err)
+		}
+	})
+
+	t.Run("given Harvest error when called through the daemon then returns an API error", func(t *testing.T) {
+		api, path := startDaemon(t)
+		client := dial(t, path)
+		api.SetError(harvest.NewAPIError("failed to fetch time entries", http.StatusTooManyRequests, "Slow down"))
+
+		_, err := client.FetchTimeEntriesContext(ctx, "2025-01-15")
+
+		var apiErr *harvest.APIError
+		if !errors.As(err, &apiErr) || !apiErr.IsRateLimited() {
+			t.Fatalf("expected rate limited API error, got %v", err)
+		}
+		if err.Error() != "failed to fetch time entries with status 429: Slow down" {
+			t.Errorf("expected the original message, got %q", err.Error())
+		}
+	})
+
+	t.Run("given cancelled context when called then returns the context error", func(t *testing.T) {
+		_, path := startDaemon(t)
+		client := dial(t, path)
+		cancelled, cancel := context.WithCancel(ctx)
+		cancel()
+
+		_, err := client.FetchProjectAssignmentsContext(cancelled)
+
+		if !errors.Is(err, context.Canceled) {
+			t.Errorf("expected context.Canceled, got %v", err)
+		}
+	})
+}
+
+func TestFallback(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("given fallback when nothing requested then leaves the daemon alone", func(t *testing.T) {
+		_, path := startDaemon(t)
+
+		fallback := NewFallback(path, "12345", harvestfake.New())
+		defer fallback.Close()
+
+		if fallback.daemon != nil {
+			t.Error("expected no connection before the first request")
+		}
+	})
+
+	t.Run("given daemon for the account when requested then goes through it", func(t *testing.T) {
+		_, path := startDaemon(t)
+		fallback := NewFallback(path, "12345", harvestfake.New())
+		defer fallback.Close()
+
+		assignments, err := fallback.FetchProjectAssignmentsContext(ctx)
+
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		if len(assignments) != 2 {
+			t.Errorf("expected the daemon's assignments, got %+v", assignments)
+		}
+	})
+
+	t.Run("given daemon for another account when requested then uses the client", func(t *testing.T) {
+		_, path := startDaemon(t)
+		fallback := NewFallback(path, "99999", harvestfake.New())
+		defer fallback.Close()
+
+		assignments, err := fallback.FetchProjectAssignmentsContext(ctx)
+
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		if len(assignments) != 0 {
+			t.Errorf("expected the client's assignments, got %+v", assignments)
+		}
+	})
+
+	t.Run("given no daemon when requested then uses the client", func(t *testing.T) {
+		dir, err := os.MkdirTemp("", "htd")
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer os.RemoveAll(dir)
+		fallback := NewFallback(filepath.Join(dir, "daemon.sock"), "12345", harvestfake.New())
+		defer fallback.Close()
+
+		_, err = fallback.FetchProjectAssignmentsContext(ctx)
+
+		if err != nil {
+			t.Errorf("expected no error, got %v", err)
+		}
+	})
+}
+
+func TestListen(t *testing.T) {
+	t.Run("given stale socket file when listening then replaces it", func(t *testing.T) {
+		dir, err := os.MkdirTemp("", "htd")
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer os.RemoveAll(dir)
+		path := filepath.Join(dir, "daemon.sock")
+		if err := os.WriteFile(path, nil, 0600); err != nil {
+			t.Fatal(err)
+		}
+
+		listener, err := Listen(path)
+
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		listener.Close()
+	})
+
+	t.Run("given running daemon when listening again then fails", func(t *testing.T) {
+		_, path := startDaemon(t)
+
+		_, err := Listen(path)
+
+		if err == nil || !strings.Contains(err.Error(), "already listening") {
+			t.Errorf("expected already listening error, got %v", err)
+		}
+	})
+
+	t.Run("given socket when listening then only the owner can connect", func(t *testing.T) {
+		_, path := startDaemon(t)
+
+		info, err := os.Stat(path)
+
+		if err != nil {
+			t.Fatal(err)
+		}
+		if perm := info.Mode().Perm(); perm != 0600 {
+			t.Errorf("expected 0600, got %o", perm)
+		}
+	})
+
+	t.Run("given socket directory open to others when listening then only the owner can enter it", func(t *testing.T) {
+		dir, err := os.MkdirTemp("", "htd")
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer os.RemoveAll(dir)
+		if err := os.Chmod(dir, 0755); err != nil {
+			t.Fatal(err)
+		}
+
+		listener, err := Listen(filepath.Join(dir, "daemon.sock"))
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		defer listener.Close()
+
+		info, err := os.Stat(dir)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if perm := info.Mode().Perm(); perm != 0700 {
+			t.Errorf("expected 0700, got %o", perm)
+		}
+	})
+
+	t.Run("given missing socket directory when listening then creates it for the owner only", func(t *testing.T) {
+		parent, err := os.MkdirTemp("", "htd")
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer os.RemoveAll(parent)
+		dir := filepath.Join(parent, "harvest-tui")
+
+		listener, err := Listen(filepath.Join(dir, "daemon.sock"))
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		defer listener.Close()
+
+		info, err := os.Stat(dir)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if perm := info.Mode().Perm(); perm != 0700 {
+			t.Errorf("expected 0700, got %o", perm)
+		}
+	})
+}
@@ -0,0 +1,102 @@
+package daemon
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/planetargon/harvest-tui/internal/harvest"
+)
+
+// sessionTimeout caps how long Fallback waits on a daemon that accepts the
+// connection but does not answer.
+const sessionTimeout = time.Second
+
+// Fallback is a harvest.API that goes through the daemon when one signed in
+// to the same account is running, and through its own client otherwise. It
+// only looks for the daemon on its first request, so commands answered from a
+// cache never touch the socket.
+type Fallback struct {
+	path      string
+	accountID string
+	client    harvest.API
+
+	once   sync.Once
+	api    harvest.API
+	daemon *Client
+}
+
+var _ harvest.API = (*Fallback)(nil)
+
+// NewFallback returns a Fallback to the daemon on path, or to client.
+func NewFallback(path, accountID string, client harvest.API) *Fallback {
+	return &Fallback{path: path, accountID: accountID, client: client}
+}
+
+// Close closes the connection to the daemon, if one was made.
+func (f *Fallback) Close() error {
+	if f.daemon != nil {
+		return f.daemon.Close()
+	}
+	return nil
+}
+
+// resolve picks the daemon or the client on first use.
+func (f *Fallback) resolve(ctx context.Context) harvest.API {
+	f.once.Do(func() {
+		f.api = f.client
+		client, err := Dial(f.path)
+		if err != nil {
+			return
+		}
+		ctx, cancel := context.WithTimeout(ctx, sessionTimeout)
+		defer cancel()
+		session, err := client.Session(ctx)
+		if err != nil || session.AccountID != f.accountID {
+			client.Close()
+			return
+		}
+		f.api, f.daemon = client, client
+	})
+	return f.api
+}
+
+func (f *Fallback) FetchProjectAssignmentsContext(ctx context.Context) ([]harvest.ProjectAssignment, error) {
+	return f.resolve(ctx).FetchProjectAssignmentsContext(ctx)
+}
+
+func (f *Fallback) FetchTimeEntriesContext(ctx context.Context, date string) ([]harvest.TimeEntry, error) {
+	return f.resolve(ctx).FetchTimeEntriesContext(ctx, date)
+}
+
+func (f *Fallback) FetchTimeEntriesRangeContext(ctx context.Context, from, to string) ([]harvest.TimeEntry, error) {
+	return f.resolve(ctx).FetchTimeEntriesRangeContext(ctx, from, to)
+}
+
+func (f *Fallback) FetchTimeEntryChangesContext(ctx context.Context, from, to string, since time.Time) (*harvest.TimeEntryChanges, error) {
+	return f.resolve(ctx).FetchTimeEntryChangesContext(ctx, from, to, since)
+}
+
+func (f *Fallback) FetchRunningTimeEntryContext(ctx context.Context) (*harvest.TimeEntry, error) {
+	return f.resolve(ctx).FetchRunningTimeEntryContext(ctx)
+}
+
+func (f *Fallback) CreateTimeEntryContext(ctx context.Context, request harvest.CreateTimeEntryRequest) (*harvest.TimeEntry, error) {
+	return f.resolve(ctx).CreateTimeEntryContext(ctx, request)
+}
+
+func (f *Fallback) UpdateTimeEntryContext(ctx context.Context, id int, request harvest.UpdateTimeEntryRequest) (*harvest.TimeEntry, error) {
+	return f.resolve(ctx).UpdateTimeEntryContext(ctx, id, request)
+}
+
+func (f *Fallback) DeleteTimeEntryContext(ctx context.Context, id int) error {
+	return f.resolve(ctx).DeleteTimeEntryContext(ctx, id)
+}
+
+func (f *Fallback) RestartTimeEntryContext(ctx context.Context, id int) (*harvest.TimeEntry, error) {
+	return f.resolve(ctx).RestartTimeEntryContext(ctx, id)
+}
+
+func (f *Fallback) StopTimeEntryContext(ctx context.Context, id int) (*harvest.TimeEntry, error) {
+	return f.resolve(ctx).StopTimeEntryContext(ctx, id)
+}
@@ -0,0 +1,152 @@
+package daemon
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/planetargon/harvest-tui/internal/export"
+	"github.com/planetargon/harvest-tui/internal/harvest"
+)
+
+// errNoTimer is returned by Stop and AppendNote when no timer is running.
+var errNoTimer = errors.New("no timer is running")
+
+// Empty is the argument to methods that take none.
+type Empty struct{}
+
+// StartArgs names the timer to start, e.g. {"project": "Website/Development"}.
+type StartArgs struct {
+	Project string `json:"project"`
+	Notes   string `json:"notes"`
+}
+
+// AppendNoteArgs is the text to add to the running timer's notes.
+type AppendNoteArgs struct {
+	Text string `json:"text"`
+}
+
+// SwitchReply is the timer Switch stopped, if one was running, and the one it
+// started.
+type SwitchReply struct {
+	Stopped *export.Entry `json:"stopped"`
+	Started export.Entry  `json:"started"`
+}
+
+// harvestService is the "Harvest" RPC service for editors and scripts. Its
+// replies follow the export schema, like the CLI's JSON output.
+type harvestService struct {
+	server *Server
+}
+
+// Status replies with the running timer, if any.
+func (h *harvestService) Status(_ Empty, reply *export.Status) error {
+	entry, err := h.server.client.FetchRunningTimeEntryContext(context.Background())
+	if err != nil {
+		return encodeError(err)
+	}
+	if entry != nil {
+		running := export.FromTimeEntry(*entry)
+		reply.Running = &running
+	}
+	return nil
+}
+
+// Start starts a timer today on the project and task named by args.
+func (h *harvestService) Start(args StartArgs, reply *export.Entry) error {
+	entry, err := h.server.start(context.Background(), args)
+	if err != nil {
+		return encodeError(err)
+	}
+	*reply = export.FromTimeEntry(*entry)
+	return nil
+}
+
+// Stop stops the running timer.
+func (h *harvestService) Stop(_ Empty, reply *export.Entry) error {
+	entry, err := h.server.stopRunning(context.Background())
+	if err != nil {
+		return encodeError(err)
+	}
+	if entry == nil {
+		return errNoTimer
+	}
+	*reply = export.FromTimeEntry(*entry)
+	return nil
+}
+
+// Switch stops the running timer, if any, and starts one on the project and
+// task named by args.
+func (h *harvestService) Switch(args StartArgs, reply *SwitchReply) error {
+	ctx := context.Background()
+	// Resolve the name first so a typo leaves the running timer alone
+	if _, _, err := h.server.findProjectTask(ctx, args.Project); err != nil {
+		return encodeError(err)
+	}
+	stopped, err := h.server.stopRunning(ctx)
+	if err != nil {
+		return encodeError(err)
+	}
+	started, err := h.server.start(ctx, args)
+	if err != nil {
+		return encodeError(err)
+	}
+	if stopped != nil {
+		entry := export.FromTimeEntry(*stopped)
+		reply.Stopped = &entry
+	}
+	reply.Started = export.FromTimeEntry(*started)
+	return nil
+}
+
+// AppendNote adds a line to the running timer's notes.
+func (h *harvestService) AppendNote(args AppendNoteArgs, reply *export.Entry) error {
+	ctx := context.Background()
+	text := strings.TrimSpace(args.Text)
+	if text == "" {
+		return errors.New("text cannot be empty")
+	}
+	running, err := h.server.client.FetchRunningTimeEntryContext(ctx)
+	if err != nil {
+		return encodeError(err)
+	}
+	if running == nil {
+		return errNoTimer
+	}
+
+	notes := text
+	if running.Notes != "" {
+		notes = running.Notes + "\n" + text
+	}
+	entry, err := h.server.client.UpdateTimeEntryContext(ctx, running.ID, harvest.UpdateTimeEntryRequest{Notes: &notes})
+	if err != nil {
+		return encodeError(err)
+	}
+	*reply = export.FromTimeEntry(*entry)
+	return nil
+}
+
+// start starts a running timer today on the project and task named by args.
+func (s *Server) start(ctx context.Context, args StartArgs) (*harvest.TimeEntry, error) {
+	project, task, err := s.findProjectTask(ctx, args.Project)
+	if err != nil {
+		return nil, err
+	}
+	// Leaving hours out starts a running timer
+	return s.client.CreateTimeEntryContext(ctx, harvest.CreateTimeEntryRequest{
+		ProjectID: project.ID,
+		TaskID:    task.ID,
+		SpentDate: s.now().Format("2006-01-02"),
+		Notes:     args.Notes,
+	})
+}
+
+// stopRunning stops the running timer and returns it, or nil when none was
+// running.
+func (s *Server) stopRunning(ctx context.Context) (*harvest.TimeEntry, error) {
+	running, err := s.client.FetchRunningTimeEntryContext(ctx)
+	if err != nil || running == nil {
+		return nil, err
+	}
+	return s.client.StopTimeEntryContext(ctx, running.ID)
+}
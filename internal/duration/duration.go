@@ -0,0 +1,269 @@
+// Package duration parses the durations and times of day typed into the app
+// and the command line, such as "1:30", "1h30m" or "9:15-10:45".
+package duration
+
+import (
+	"fmt"
+	"math"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// FormatHelp lists the accepted duration formats for error messages.
+const FormatHelp = "Use 1:30, 1.5, 1h30m, 90m or 9:15-10:45"
+
+// bareMinutesThreshold is the smallest whole number without a unit or colon
+// that is read as minutes. Smaller ones are hours, so "8" is 8:00 while "45"
+// is 0:45: nobody logs 45 hours, and 10+ hour entries are rare enough to spell
+// out as "10h" or "10:00".
+const bareMinutesThreshold = 10
+
+var (
+	// decimalPattern matches a plain number without sign or exponent.
+	decimalPattern = regexp.MustCompile(`^(\d+\.?\d*|\.\d+)$`)
+	// unitsPattern matches one or more number-unit pairs such as "1h30m".
+	unitsPattern = regexp.MustCompile(`^((\d+\.?\d*|\.\d+)[hm])+$`)
+	unitPattern  = regexp.MustCompile(`(\d+\.?\d*|\.\d+)([hm])`)
+	// clockPattern matches a time of day such as "9:15", "14:30" or "8:00am".
+	clockPattern = regexp.MustCompile(`^` + clockExpr + `$`)
+	// timeRangePattern matches a start and end time such as "09:15–10:45" or
+	// "9am-1:30pm". Each side needs a colon or am/pm so "9-10" stays arithmetic.
+	timeRangePattern = regexp.MustCompile(`^` + clockExpr + `(?:–|—|-|to)` + clockExpr + `$`)
+)
+
+// clockExpr captures the hour, minutes and am/pm of a time of day.
+const clockExpr = `(\d{1,2})(?::(\d{2}))?(am|pm)?`
+
+// Range is a start and end time of day, in minutes since midnight.
+type Range struct {
+	Start, End int
+}
+
+// Hours returns the length of the range in hours.
+func (r Range) Hours() float64 {
+	return float64(r.End-r.Start) / 60
+}
+
+// String formats the range the way Harvest shows times, e.g. "9:15am–10:45am".
+func (r Range) String() string {
+	return FormatClock(r.Start) + "–" + FormatClock(r.End)
+}
+
+// ParseRange parses a start–end time range. ok is false when input is not
+// a range or the end is not after the start, in which case "1:30-0:15" is left
+// to be read as arithmetic.
+func ParseRange(input string) (Range, bool) {
+	expr := strings.ToLower(strings.Join(strings.Fields(input), ""))
+	match := timeRangePattern.FindStringSubmatch(expr)
+	if match == nil {
+		return Range{}, false
+	}
+	// Without a colon or am/pm a side is just a number, e.g. "9-10"
+	if (match[2] == "" && match[3] == "") || (match[5] == "" && match[6] == "") {
+		return Range{}, false
+	}
+
+	start, ok := clockMinutes(match[1], match[2], match[3])
+	if !ok {
+		return Range{}, false
+	}
+	end, ok := clockMinutes(match[4], match[5], match[6])
+	if !ok || end <= start {
+		return Range{}, false
+	}
+	return Range{Start: start, End: end}, true
+}
+
+// ParseClock parses a single time of day such as "8:00am" or "14:30" into
+// minutes since midnight.
+func ParseClock(value string) (int, bool) {
+	match := clockPattern.FindStringSubmatch(strings.ToLower(strings.TrimSpace(value)))
+	if match == nil {
+		return 0, false
+	}
+	return clockMinutes(match[1], match[2], match[3])
+}
+
+// clockMinutes converts the parts of a matched time of day into minutes since
+// midnight, validating them for 12-hour or 24-hour clocks.
+func clockMinutes(hourStr, minuteStr, meridiem string) (int, bool) {
+	hour, _ := strconv.Atoi(hourStr)
+	minute := 0
+	if minuteStr != "" {
+		minute, _ = strconv.Atoi(minuteStr)
+	}
+	if minute >= 60 {
+		return 0, false
+	}
+
+	switch meridiem {
+	case "":
+		if hour > 23 {
+			return 0, false
+		}
+	case "am", "pm":
+		if hour < 1 || hour > 12 {
+			return 0, false
+		}
+		hour %= 12
+		if meridiem == "pm" {
+			hour += 12
+		}
+	}
+	return hour*60 + minute, true
+}
+
+// FormatClock formats minutes since midnight in Harvest's "8:00am" format.
+func FormatClock(minutes int) string {
+	hour := minutes / 60 % 24
+	meridiem := "am"
+	if hour >= 12 {
+		meridiem = "pm"
+	}
+	hour %= 12
+	if hour == 0 {
+		hour = 12
+	}
+	return fmt.Sprintf("%d:%02d%s", hour, minutes%60, meridiem)
+}
+
+// Parse parses a duration and returns hours as a float64. It accepts
+// H:MM ("1:30"), decimal hours ("1.5"), units ("1h30m", "90m"), bare whole
+// numbers ("45", see bareMinutesThreshold), sums of these ("1:30+0:15") and
+// start–end time ranges ("09:15–10:45"). The result is rounded to the minute.
+func Parse(durationStr string) (float64, error) {
+	hours, relative, err := evalDuration(durationStr)
+	if err != nil {
+		return 0, err
+	}
+	if relative {
+		return 0, fmt.Errorf("invalid duration format. %s", FormatHelp)
+	}
+	return hours, nil
+}
+
+// ParseRelative parses a duration like Parse, but a leading
+// sign adjusts current instead: "-0:15" takes 15 minutes off and "+1h" adds
+// an hour. The result cannot be negative.
+func ParseRelative(durationStr string, current float64) (float64, error) {
+	hours, relative, err := evalDuration(durationStr)
+	if err != nil {
+		return 0, err
+	}
+	if relative {
+		hours = roundToMinute(current + hours)
+	}
+	if hours < 0 {
+		return 0, fmt.Errorf("duration cannot be negative")
+	}
+	return hours, nil
+}
+
+// IsRelative reports whether a duration starts with a sign, making it an
+// adjustment to the current duration for ParseRelative.
+func IsRelative(durationStr string) bool {
+	_, relative, err := evalDuration(durationStr)
+	return err == nil && relative
+}
+
+// evalDuration sums the signed terms of a duration expression. relative is
+// true when the expression starts with a sign, making it an adjustment.
+func evalDuration(durationStr string) (hours float64, relative bool, err error) {
+	expr := strings.ToLower(strings.Join(strings.Fields(durationStr), ""))
+	if expr == "" {
+		return 0, false, fmt.Errorf("duration cannot be empty")
+	}
+	if r, ok := ParseRange(expr); ok {
+		return roundToMinute(r.Hours()), false, nil
+	}
+	relative = expr[0] == '+' || expr[0] == '-'
+
+	total := 0.0
+	sign := 1.0
+	start := 0
+	if relative {
+		if expr[0] == '-' {
+			sign = -1
+		}
+		start = 1
+	}
+	for i := start; i <= len(expr); i++ {
+		if i < len(expr) && expr[i] != '+' && expr[i] != '-' {
+			continue
+		}
+		term, err := parseDurationTerm(expr[start:i])
+		if err != nil {
+			return 0, false, err
+		}
+		total += sign * term
+		if i < len(expr) && expr[i] == '-' {
+			sign = -1
+		} else {
+			sign = 1
+		}
+		start = i + 1
+	}
+
+	hours = roundToMinute(total)
+	if !relative && hours < 0 {
+		return 0, false, fmt.Errorf("duration cannot be negative")
+	}
+	return hours, relative, nil
+}
+
+// parseDurationTerm parses a single unsigned duration term into hours.
+func parseDurationTerm(term string) (float64, error) {
+	invalid := fmt.Errorf("invalid duration format. %s", FormatHelp)
+
+	switch {
+	case strings.Contains(term, ":"):
+		parts := strings.Split(term, ":")
+		if len(parts) != 2 || !isDigits(parts[0]) || !isDigits(parts[1]) {
+			return 0, invalid
+		}
+		h, _ := strconv.Atoi(parts[0])
+		m, _ := strconv.Atoi(parts[1])
+		if m >= 60 {
+			return 0, invalid
+		}
+		return float64(h) + float64(m)/60.0, nil
+
+	case unitsPattern.MatchString(term):
+		hours := 0.0
+		for _, match := range unitPattern.FindAllStringSubmatch(term, -1) {
+			value, _ := strconv.ParseFloat(match[1], 64)
+			if match[2] == "m" {
+				value /= 60
+			}
+			hours += value
+		}
+		return hours, nil
+
+	case decimalPattern.MatchString(term):
+		value, _ := strconv.ParseFloat(term, 64)
+		if isDigits(term) && value >= bareMinutesThreshold {
+			return value / 60, nil
+		}
+		return value, nil
+	}
+	return 0, invalid
+}
+
+// isDigits reports whether s is a non-empty run of ASCII digits.
+func isDigits(s string) bool {
+	if s == "" {
+		return false
+	}
+	for _, r := range s {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+	return true
+}
+
+// roundToMinute rounds hours to the nearest whole minute.
+func roundToMinute(hours float64) float64 {
+	return math.Round(hours*60) / 60
+}
@@ -0,0 +1,288 @@
+package duration
+
+import (
+	"fmt"
+	"math"
+	"testing"
+)
+
+// clock formats hours as H:MM so results compare to the minute.
+func clock(hours float64) string {
+	minutes := int(math.Round(hours * 60))
+	return fmt.Sprintf("%d:%02d", minutes/60, minutes%60)
+}
+
+func TestParse(t *testing.T) {
+	t.Run("given valid duration 1:30 when parsed then returns 1.5 hours", func(t *testing.T) {
+		result, err := Parse("1:30")
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		expected := 1.5
+		if result != expected {
+			t.Errorf("expected %f, got %f", expected, result)
+		}
+	})
+
+	t.Run("given valid duration 0:15 when parsed then returns 0.25 hours", func(t *testing.T) {
+		result, err := Parse("0:15")
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		expected := 0.25
+		if result != expected {
+			t.Errorf("expected %f, got %f", expected, result)
+		}
+	})
+
+	t.Run("given valid duration 2:45 when parsed then returns 2.75 hours", func(t *testing.T) {
+		result, err := Parse("2:45")
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		expected := 2.75
+		if result != expected {
+			t.Errorf("expected %f, got %f", expected, result)
+		}
+	})
+
+	t.Run("given valid duration 0:00 when parsed then returns 0 hours", func(t *testing.T) {
+		result, err := Parse("0:00")
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		expected := 0.0
+		if result != expected {
+			t.Errorf("expected %f, got %f", expected, result)
+		}
+	})
+
+	t.Run("given empty string when parsed then returns error", func(t *testing.T) {
+		_, err := Parse("")
+		if err == nil {
+			t.Fatal("expected error for empty string")
+		}
+		if err.Error() != "duration cannot be empty" {
+			t.Errorf("expected 'duration cannot be empty', got '%s'", err.Error())
+		}
+	})
+
+	t.Run("given whitespace only when parsed then returns error", func(t *testing.T) {
+		_, err := Parse("   ")
+		if err == nil {
+			t.Fatal("expected error for whitespace only")
+		}
+		if err.Error() != "duration cannot be empty" {
+			t.Errorf("expected 'duration cannot be empty', got '%s'", err.Error())
+		}
+	})
+
+	t.Run("given invalid format abc when parsed then returns validation error", func(t *testing.T) {
+		_, err := Parse("abc")
+		if err == nil {
+			t.Fatal("expected error for invalid format")
+		}
+		expectedMsg := "invalid duration format. " + FormatHelp
+		if err.Error() != expectedMsg {
+			t.Errorf("expected '%s', got '%s'", expectedMsg, err.Error())
+		}
+	})
+
+	t.Run("given invalid format 1:2:3 when parsed then returns validation error", func(t *testing.T) {
+		_, err := Parse("1:2:3")
+		if err == nil {
+			t.Fatal("expected error for too many parts")
+		}
+		expectedMsg := "invalid duration format. " + FormatHelp
+		if err.Error() != expectedMsg {
+			t.Errorf("expected '%s', got '%s'", expectedMsg, err.Error())
+		}
+	})
+
+	t.Run("given invalid hours abc:30 when parsed then returns validation error", func(t *testing.T) {
+		_, err := Parse("abc:30")
+		if err == nil {
+			t.Fatal("expected error for non-numeric hours")
+		}
+		expectedMsg := "invalid duration format. " + FormatHelp
+		if err.Error() != expectedMsg {
+			t.Errorf("expected '%s', got '%s'", expectedMsg, err.Error())
+		}
+	})
+
+	t.Run("given invalid minutes 1:abc when parsed then returns validation error", func(t *testing.T) {
+		_, err := Parse("1:abc")
+		if err == nil {
+			t.Fatal("expected error for non-numeric minutes")
+		}
+		expectedMsg := "invalid duration format. " + FormatHelp
+		if err.Error() != expectedMsg {
+			t.Errorf("expected '%s', got '%s'", expectedMsg, err.Error())
+		}
+	})
+
+	t.Run("given negative hours -1:30 when parsed then returns validation error", func(t *testing.T) {
+		_, err := Parse("-1:30")
+		if err == nil {
+			t.Fatal("expected error for negative hours")
+		}
+		expectedMsg := "invalid duration format. " + FormatHelp
+		if err.Error() != expectedMsg {
+			t.Errorf("expected '%s', got '%s'", expectedMsg, err.Error())
+		}
+	})
+
+	t.Run("given invalid minutes 1:60 when parsed then returns validation error", func(t *testing.T) {
+		_, err := Parse("1:60")
+		if err == nil {
+			t.Fatal("expected error for minutes >= 60")
+		}
+		expectedMsg := "invalid duration format. " + FormatHelp
+		if err.Error() != expectedMsg {
+			t.Errorf("expected '%s', got '%s'", expectedMsg, err.Error())
+		}
+	})
+
+	t.Run("given negative minutes 1:-15 when parsed then returns validation error", func(t *testing.T) {
+		_, err := Parse("1:-15")
+		if err == nil {
+			t.Fatal("expected error for negative minutes")
+		}
+		expectedMsg := "invalid duration format. " + FormatHelp
+		if err.Error() != expectedMsg {
+			t.Errorf("expected '%s', got '%s'", expectedMsg, err.Error())
+		}
+	})
+}
+
+func TestParseFormats(t *testing.T) {
+	tests := []struct {
+		input   string
+		want    float64
+		wantErr bool
+	}{
+		// H:MM
+		{"1:30", 1.5, false},
+		{"0:05", 5.0 / 60, false},
+		{"10:00", 10, false},
+		{"1:5", 5.0/60 + 1, false},
+		{"1:60", 0, true},
+		{":30", 0, true},
+		{"1:", 0, true},
+
+		// Decimal hours
+		{"1.5", 1.5, false},
+		{".25", 0.25, false},
+		{"2.", 2, false},
+		{"10.0", 10, false},
+		{"1.2.3", 0, true},
+		{"1e2", 0, true},
+
+		// Units
+		{"1h30m", 1.5, false},
+		{"1h 30m", 1.5, false},
+		{"90m", 1.5, false},
+		{"1.5h", 1.5, false},
+		{"2H", 2, false},
+		{"45m", 0.75, false},
+		{"10h", 10, false},
+		{"1h30", 0, true},
+		{"h", 0, true},
+		{"30s", 0, true},
+
+		// Bare whole numbers: hours below the threshold, minutes from it
+		{"0", 0, false},
+		{"8", 8, false},
+		{"9", 9, false},
+		{"10", 10.0 / 60, false},
+		{"45", 0.75, false},
+		{"90", 1.5, false},
+		{"08", 8, false},
+
+		// Arithmetic
+		{"1:30+0:15", 1.75, false},
+		{"1h-15m", 0.75, false},
+		{"1:30 + 45", 2.25, false},
+		{"1.5+.5", 2, false},
+		{"15m-1h", 0, true},
+		{"1:30+", 0, true},
+		{"1:30++0:15", 0, true},
+
+		// Time ranges: each side needs a colon or am/pm, and the end must be later
+		{"09:15–10:45", 1.5, false},
+		{"9:15-10:45", 1.5, false},
+		{"9:15 to 10:45", 1.5, false},
+		{"9am-1:30pm", 4.5, false},
+		{"11:30am–12:15pm", 0.75, false},
+		{"0:15-1:00", 0.75, false},
+		{"1:30-0:15", 1.25, false},   // End is earlier, so 1:30 minus 0:15
+		{"9-10", 8 + 50.0/60, false}, // Bare numbers, so 9 hours minus 10 minutes
+		{"9:00-25:00", 0, true},
+		{"13:00pm-14:00", 0, true},
+
+		// Leading signs are relative and need an existing duration
+		{"-0:15", 0, true},
+		{"+1h", 0, true},
+
+		// Rounds to the minute
+		{"0.1", 6.0 / 60, false},
+		{"1.999", 2, false},
+
+		{"", 0, true},
+		{"abc", 0, true},
+	}
+
+	for _, tt := range tests {
+		t.Run("given '"+tt.input+"' when parsed then returns expected hours", func(t *testing.T) {
+			got, err := Parse(tt.input)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("expected error, got %v", got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("expected no error, got %v", err)
+			}
+			if clock(got) != clock(tt.want) {
+				t.Errorf("expected %s, got %s", clock(tt.want), clock(got))
+			}
+		})
+	}
+}
+
+func TestParseRelative(t *testing.T) {
+	tests := []struct {
+		input   string
+		current float64
+		want    float64
+		wantErr bool
+	}{
+		{"-0:15", 1.5, 1.25, false},
+		{"+1h", 1.5, 2.5, false},
+		{"+30", 1, 1.5, false},
+		{"-15m+5m", 1, 50.0 / 60, false},
+		{"2:00", 1.5, 2, false},
+		{"-2h", 1.5, 0, true},
+		{"-1:30", 1.5, 0, false},
+		{"-", 1, 0, true},
+	}
+
+	for _, tt := range tests {
+		t.Run("given '"+tt.input+"' and current "+clock(tt.current)+" when parsed then adjusts current", func(t *testing.T) {
+			got, err := ParseRelative(tt.input, tt.current)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("expected error, got %v", got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("expected no error, got %v", err)
+			}
+			if clock(got) != clock(tt.want) {
+				t.Errorf("expected %s, got %s", clock(tt.want), clock(got))
+			}
+		})
+	}
+}
@@ -0,0 +1,258 @@
+// Package export serializes time entries for scripts and spreadsheets. Its
+// types are a stable schema of their own rather than Harvest's payloads, so
+// fields Harvest adds or renames never change the output.
+package export
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/planetargon/harvest-tui/internal/harvest"
+)
+
+// Format is an output format for command results.
+type Format string
+
+const (
+	FormatTable Format = "table" // Aligned text for people
+	FormatJSON  Format = "json"  // JSON for jq and scripts
+	FormatCSV   Format = "csv"   // CSV with a header row for spreadsheets
+)
+
+// ParseFormat parses a --output value.
+func ParseFormat(s string) (Format, error) {
+	switch format := Format(s); format {
+	case FormatTable, FormatJSON, FormatCSV:
+		return format, nil
+	}
+	return "", fmt.Errorf("invalid output format %q, use table, json or csv", s)
+}
+
+// Entry is a time entry in the export schema. Fields are only ever added,
+// never renamed or removed.
+type Entry struct {
+	ID          int     `json:"id"`
+	Date        string  `json:"date"` // YYYY-MM-DD
+	ClientID    int     `json:"client_id"`
+	Client      string  `json:"client"`
+	ProjectID   int     `json:"project_id"`
+	Project     string  `json:"project"`
+	TaskID      int     `json:"task_id"`
+	Task        string  `json:"task"`
+	Notes       string  `json:"notes"`
+	Hours       float64 `json:"hours"`        // Decimal hours, rounded to 2 places
+	Duration    string  `json:"duration"`     // Hours as H:MM
+	StartedTime string  `json:"started_time"` // "8:00am" on accounts tracking start and end times, else empty
+	EndedTime   string  `json:"ended_time"`   // Empty while running or when not tracked
+	Billable    bool    `json:"billable"`
+	Running     bool    `json:"running"`
+	Locked      bool    `json:"locked"` // Approved or invoiced, so no longer editable
+}
+
+// Totals sums a set of entries.
+type Totals struct {
+	Entries          int     `json:"entries"`
+	Hours            float64 `json:"hours"`
+	Duration         string  `json:"duration"`
+	BillableHours    float64 `json:"billable_hours"`
+	BillableDuration string  `json:"billable_duration"`
+}
+
+// Day is a day's entries and their totals, as listed by "harvest-tui list".
+type Day struct {
+	Date    string  `json:"date"`
+	Entries []Entry `json:"entries"`
+	Totals  Totals  `json:"totals"`
+}
+
+// Status is the timer running on any day, as shown by "harvest-tui status".
+// Running is null when no timer is running.
+type Status struct {
+	Running *Entry `json:"running"`
+}
+
+// FromTimeEntry converts a Harvest time entry to the export schema.
+func FromTimeEntry(entry harvest.TimeEntry) Entry {
+	return Entry{
+		ID:          entry.ID,
+		Date:        entry.SpentDate,
+		ClientID:    entry.Client.ID,
+		Client:      entry.Client.Name,
+		ProjectID:   entry.Project.ID,
+		Project:     entry.Project.Name,
+		TaskID:      entry.Task.ID,
+		Task:        entry.Task.Name,
+		Notes:       entry.Notes,
+		Hours:       roundHours(entry.Hours),
+		Duration:    FormatDuration(entry.Hours),
+		StartedTime: entry.StartedTime,
+		EndedTime:   entry.EndedTime,
+		Billable:    entry.IsBillable,
+		Running:     entry.IsRunning,
+		Locked:      entry.IsLocked,
+	}
+}
+
+// FromTimeEntries converts Harvest time entries to the export schema.
+func FromTimeEntries(entries []harvest.TimeEntry) []Entry {
+	converted := make([]Entry, 0, len(entries))
+	for _, entry := range entries {
+		converted = append(converted, FromTimeEntry(entry))
+	}
+	return converted
+}
+
+// Sum totals entries.
+func Sum(entries []Entry) Totals {
+	var hours, billable float64
+	for _, entry := range entries {
+		hours += entry.Hours
+		if entry.Billable {
+			billable += entry.Hours
+		}
+	}
+	return Totals{
+		Entries:          len(entries),
+		Hours:            roundHours(hours),
+		Duration:         FormatDuration(hours),
+		BillableHours:    roundHours(billable),
+		BillableDuration: FormatDuration(billable),
+	}
+}
+
+// FormatDuration formats hours as H:MM.
+func FormatDuration(hours float64) string {
+	minutes := int(math.Round(hours * 60))
+	return fmt.Sprintf("%d:%02d", minutes/60, minutes%60)
+}
+
+func roundHours(hours float64) float64 {
+	return math.Round(hours*100) / 100
+}
+
+// WriteJSON writes v as indented JSON.
+func WriteJSON(w io.Writer, v any) error {
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(v)
+}
+
+// Columns names every CSV column, in the order of Entry's fields.
+var Columns = []string{
+	"id", "date", "client_id", "client", "project_id", "project", "task_id", "task",
+	"notes", "hours", "duration", "started_time", "ended_time", "billable", "running", "locked",
+}
+
+// DefaultColumns are the columns exported for invoicing reviews unless others
+// are chosen.
+var DefaultColumns = []string{
+	"date", "client", "project", "task", "notes", "hours", "duration", "billable", "locked",
+}
+
+// ParseColumns parses a comma-separated list of column names.
+func ParseColumns(list string) ([]string, error) {
+	var columns []string
+	for _, column := range strings.Split(list, ",") {
+		if column = strings.TrimSpace(column); column != "" {
+			columns = append(columns, column)
+		}
+	}
+	if err := ValidateColumns(columns); err != nil {
+		return nil, err
+	}
+	return columns, nil
+}
+
+// ValidateColumns checks that columns is not empty and names only known columns.
+func ValidateColumns(columns []string) error {
+	if len(columns) == 0 {
+		return fmt.Errorf("no columns chosen, use some of %s", strings.Join(Columns, ", "))
+	}
+	for _, column := range columns {
+		if !slices.Contains(Columns, column) {
+			return fmt.Errorf("unknown column %q, use %s", column, strings.Join(Columns, ", "))
+		}
+	}
+	return nil
+}
+
+// WriteCSV writes entries as CSV with a header row, even when there are none.
+func WriteCSV(w io.Writer, entries []Entry) error {
+	return WriteCSVColumns(w, entries, Columns)
+}
+
+// WriteCSVColumns is like WriteCSV but writes only the given columns, in the
+// order given. The columns must have passed ValidateColumns.
+func WriteCSVColumns(w io.Writer, entries []Entry, columns []string) error {
+	writer := csv.NewWriter(w)
+	if err := writer.Write(columns); err != nil {
+		return err
+	}
+	record := make([]string, len(columns))
+	for _, e := range entries {
+		for i, column := range columns {
+			record[i] = e.field(column)
+		}
+		if err := writer.Write(record); err != nil {
+			return err
+		}
+	}
+	writer.Flush()
+	return writer.Error()
+}
+
+// field returns the value of the named CSV column.
+func (e Entry) field(column string) string {
+	switch column {
+	case "id":
+		return strconv.Itoa(e.ID)
+	case "date":
+		return e.Date
+	case "client_id":
+		return strconv.Itoa(e.ClientID)
+	case "client":
+		return e.Client
+	case "project_id":
+		return strconv.Itoa(e.ProjectID)
+	case "project":
+		return e.Project
+	case "task_id":
+		return strconv.Itoa(e.TaskID)
+	case "task":
+		return e.Task
+	case "notes":
+		return e.Notes
+	case "hours":
+		return strconv.FormatFloat(e.Hours, 'f', 2, 64)
+	case "duration":
+		return e.Duration
+	case "started_time":
+		return e.StartedTime
+	case "ended_time":
+		return e.EndedTime
+	case "billable":
+		return strconv.FormatBool(e.Billable)
+	case "running":
+		return strconv.FormatBool(e.Running)
+	case "locked":
+		return strconv.FormatBool(e.Locked)
+	}
+	return ""
+}
+
+// SortByDate orders entries oldest first, by date and then by when they were
+// created, as spreadsheets expect.
+func SortByDate(entries []Entry) {
+	slices.SortStableFunc(entries, func(a, b Entry) int {
+		if c := strings.Compare(a.Date, b.Date); c != 0 {
+			return c
+		}
+		return a.ID - b.ID
+	})
+}
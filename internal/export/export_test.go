@@ -0,0 +1,197 @@
+package export
+
+import (
+	"bytes"
+	"encoding/json"
+	"math"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/planetargon/harvest-tui/internal/harvest"
+)
+
+func sampleTimeEntries() []harvest.TimeEntry {
+	return []harvest.TimeEntry{
+		{
+			ID:          1,
+			SpentDate:   "2025-01-15",
+			Hours:       1.5,
+			StartedTime: "9:00am",
+			EndedTime:   "10:30am",
+			Notes:       "Standup, then \"review\"",
+			IsBillable:  true,
+			IsLocked:    true,
+			Client:      harvest.TimeEntryClient{ID: 1, Name: "Acme"},
+			Project:     harvest.TimeEntryProject{ID: 10, Name: "Website"},
+			Task:        harvest.TimeEntryTask{ID: 100, Name: "Development"},
+		},
+		{
+			ID:        2,
+			SpentDate: "2025-01-15",
+			Hours:     0.256,
+			IsRunning: true,
+			Client:    harvest.TimeEntryClient{ID: 2, Name: "Globex"},
+			Project:   harvest.TimeEntryProject{ID: 20, Name: "Mobile"},
+			Task:      harvest.TimeEntryTask{ID: 101, Name: "Design"},
+		},
+	}
+}
+
+func TestExport(t *testing.T) {
+	t.Run("given time entry when converted then keeps the schema's field names", func(t *testing.T) {
+		data, err := json.Marshal(FromTimeEntry(sampleTimeEntries()[0]))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		want := `{"id":1,"date":"2025-01-15","client_id":1,"client":"Acme","project_id":10,"project":"Website",` +
+			`"task_id":100,"task":"Development","notes":"Standup, then \"review\"","hours":1.5,"duration":"1:30",` +
+			`"started_time":"9:00am","ended_time":"10:30am","billable":true,"running":false,"locked":true}`
+		if string(data) != want {
+			t.Errorf("expected:\n%s\ngot:\n%s", want, data)
+		}
+	})
+
+	t.Run("given entries when summed then totals all and billable hours", func(t *testing.T) {
+		totals := Sum(FromTimeEntries(sampleTimeEntries()))
+
+		want := Totals{Entries: 2, Hours: 1.76, Duration: "1:46", BillableHours: 1.5, BillableDuration: "1:30"}
+		if totals != want {
+			t.Errorf("expected %+v, got %+v", want, totals)
+		}
+	})
+
+	t.Run("given no entries when summed then totals are zero", func(t *testing.T) {
+		totals := Sum(FromTimeEntries(nil))
+
+		if totals != (Totals{Duration: "0:00", BillableDuration: "0:00"}) {
+			t.Errorf("expected zero totals, got %+v", totals)
+		}
+	})
+
+	t.Run("given entries when written as CSV then has a header and quotes notes", func(t *testing.T) {
+		var buf bytes.Buffer
+
+		if err := WriteCSV(&buf, FromTimeEntries(sampleTimeEntries())); err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+
+		want := "id,date,client_id,client,project_id,project,task_id,task,notes,hours,duration,started_time,ended_time,billable,running,locked\n" +
+			"1,2025-01-15,1,Acme,10,Website,100,Development,\"Standup, then \"\"review\"\"\",1.50,1:30,9:00am,10:30am,true,false,true\n" +
+			"2,2025-01-15,2,Globex,20,Mobile,101,Design,,0.26,0:15,,,false,true,false\n"
+		if buf.String() != want {
+			t.Errorf("expected:\n%s\ngot:\n%s", want, buf.String())
+		}
+	})
+
+	t.Run("given no running timer when status written as JSON then running is null", func(t *testing.T) {
+		var buf bytes.Buffer
+
+		if err := WriteJSON(&buf, Status{}); err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+
+		if buf.String() != "{\n  \"running\": null\n}\n" {
+			t.Errorf("unexpected JSON %q", buf.String())
+		}
+	})
+
+	t.Run("given output names when parsed then accepts only known formats", func(t *testing.T) {
+		for _, name := range []string{"table", "json", "csv"} {
+			if format, err := ParseFormat(name); err != nil || string(format) != name {
+				t.Errorf("expected %s accepted, got %q %v", name, format, err)
+			}
+		}
+		if _, err := ParseFormat("yaml"); err == nil {
+			t.Error("expected yaml rejected")
+		}
+	})
+
+	t.Run("given chosen columns when written as CSV then writes only those, in order", func(t *testing.T) {
+		var buf bytes.Buffer
+
+		err := WriteCSVColumns(&buf, FromTimeEntries(sampleTimeEntries()), []string{"task", "hours", "date", "billable"})
+
+		if err != nil {
+			t.Fatal(err)
+		}
+		want := "task,hours,date,billable\nDevelopment,1.50,2025-01-15,true\nDesign,0.26,2025-01-15,false\n"
+		if buf.String() != want {
+			t.Errorf("expected:\n%s\ngot:\n%s", want, buf.String())
+		}
+	})
+
+	t.Run("given column list when parsed then accepts only known columns", func(t *testing.T) {
+		columns, err := ParseColumns("date, client ,hours")
+		if err != nil || len(columns) != 3 || columns[1] != "client" {
+			t.Errorf("expected three columns, got %v %v", columns, err)
+		}
+		if _, err := ParseColumns("date,rate"); err == nil || !strings.Contains(err.Error(), `unknown column "rate"`) {
+			t.Errorf("expected unknown column rejected, got %v", err)
+		}
+		if _, err := ParseColumns(" , "); err == nil {
+			t.Error("expected empty list rejected")
+		}
+	})
+
+	t.Run("given entries when sorted by date then oldest come first", func(t *testing.T) {
+		entries := []Entry{{ID: 3, Date: "2025-01-16"}, {ID: 2, Date: "2025-01-15"}, {ID: 1, Date: "2025-01-15"}}
+
+		SortByDate(entries)
+
+		if entries[0].ID != 1 || entries[1].ID != 2 || entries[2].ID != 3 {
+			t.Errorf("expected IDs 1, 2, 3, got %+v", entries)
+		}
+	})
+}
+
+func TestRounding(t *testing.T) {
+	tests := []struct {
+		name     string
+		rounding Rounding
+		hours    float64
+		want     float64
+	}{
+		{"no increment leaves hours", Rounding{}, 1.27, 1.27},
+		{"nearest rounds down below half", Rounding{Increment: 15 * time.Minute, Mode: RoundNearest}, 1.1, 1.0},
+		{"nearest rounds up from half", Rounding{Increment: 15 * time.Minute, Mode: RoundNearest}, 1.125, 1.25},
+		{"up rounds any part up", Rounding{Increment: 15 * time.Minute, Mode: RoundUp}, 1.01, 1.25},
+		{"up keeps exact multiples", Rounding{Increment: 6 * time.Minute, Mode: RoundUp}, 1.1, 1.1},
+		{"down drops any part", Rounding{Increment: 6 * time.Minute, Mode: RoundDown}, 1.19, 1.1},
+		{"empty mode rounds to nearest", Rounding{Increment: 30 * time.Minute}, 0.76, 1.0},
+		{"up keeps 10 minutes reported as 0.17", Rounding{Increment: 10 * time.Minute, Mode: RoundUp}, 0.17, 10.0 / 60},
+		{"up keeps 40 minutes reported as 0.67", Rounding{Increment: 20 * time.Minute, Mode: RoundUp}, 0.67, 40.0 / 60},
+		{"down keeps 20 minutes reported as 0.33", Rounding{Increment: 10 * time.Minute, Mode: RoundDown}, 0.33, 20.0 / 60},
+		{"up rounds a minute over 20 minutes", Rounding{Increment: 20 * time.Minute, Mode: RoundUp}, 21.0 / 60, 40.0 / 60},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.rounding.Hours(tt.hours); math.Abs(got-tt.want) > 1e-9 {
+				t.Errorf("expected %v, got %v", tt.want, got)
+			}
+		})
+	}
+
+	t.Run("given entries when reported with rounding then updates hours and duration", func(t *testing.T) {
+		rounded := Report(sampleTimeEntries(), Rounding{Increment: 15 * time.Minute, Mode: RoundUp})
+
+		if rounded[1].Hours != 0.5 || rounded[1].Duration != "0:30" {
+			t.Errorf("expected 0.26 hours rounded up to 0:30, got %v %s", rounded[1].Hours, rounded[1].Duration)
+		}
+		if rounded[0].Hours != 1.5 {
+			t.Errorf("expected exact multiple kept, got %v", rounded[0].Hours)
+		}
+	})
+
+	t.Run("given mode names when parsed then accepts only known modes", func(t *testing.T) {
+		for _, name := range []string{"nearest", "up", "down"} {
+			if mode, err := ParseRoundingMode(name); err != nil || string(mode) != name {
+				t.Errorf("expected %s accepted, got %q %v", name, mode, err)
+			}
+		}
+		if _, err := ParseRoundingMode("sideways"); err == nil {
+			t.Error("expected sideways rejected")
+		}
+	})
+}
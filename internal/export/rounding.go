@@ -0,0 +1,87 @@
+package export
+
+import (
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/planetargon/harvest-tui/internal/harvest"
+)
+
+// RoundingMode is which way Rounding moves hours to a multiple of its increment.
+type RoundingMode string
+
+const (
+	RoundNearest RoundingMode = "nearest" // To the closest multiple
+	RoundUp      RoundingMode = "up"      // To the next multiple, as Harvest's own rounding does
+	RoundDown    RoundingMode = "down"    // To the previous multiple
+)
+
+// ParseRoundingMode parses a rounding mode name. Empty means nearest.
+func ParseRoundingMode(s string) (RoundingMode, error) {
+	switch mode := RoundingMode(s); mode {
+	case "":
+		return RoundNearest, nil
+	case RoundNearest, RoundUp, RoundDown:
+		return mode, nil
+	}
+	return "", fmt.Errorf("invalid rounding mode %q, use nearest, up or down", s)
+}
+
+// Rounding rounds each entry's hours to a multiple of Increment, such as 6 or
+// 15 minutes for invoicing. The zero value leaves hours as they are.
+type Rounding struct {
+	Increment time.Duration
+	Mode      RoundingMode
+}
+
+// hoursTolerance is half of 0.01h, the precision Harvest reports hours in.
+// Hours this close to a multiple of the increment are taken as that multiple,
+// so a 10-minute entry reported as 0.17h is not rounded up to 20 minutes.
+const hoursTolerance = 18 * time.Second
+
+// Hours rounds hours to a multiple of the increment.
+func (r Rounding) Hours(hours float64) float64 {
+	if r.Increment <= 0 {
+		return hours
+	}
+	seconds := hours * 3600
+	increment := r.Increment.Seconds()
+	steps := seconds / increment
+	if nearest := math.Round(steps); math.Abs(seconds-nearest*increment) <= hoursTolerance.Seconds() {
+		return nearest * r.Increment.Hours()
+	}
+	switch r.Mode {
+	case RoundUp:
+		steps = math.Ceil(steps)
+	case RoundDown:
+		steps = math.Floor(steps)
+	default:
+		steps = math.Round(steps)
+	}
+	return steps * r.Increment.Hours()
+}
+
+// Convert converts a Harvest time entry to the export schema, rounding its
+// hours and duration from Harvest's own hours rather than the exported ones,
+// which are already rounded to hundredths.
+func (r Rounding) Convert(entry harvest.TimeEntry) Entry {
+	converted := FromTimeEntry(entry)
+	if r.Increment > 0 {
+		hours := r.Hours(entry.Hours)
+		converted.Hours = roundHours(hours)
+		converted.Duration = FormatDuration(hours)
+	}
+	return converted
+}
+
+// Report converts the entries of a date range for a spreadsheet: rounded, and
+// oldest first.
+func Report(entries []harvest.TimeEntry, rounding Rounding) []Entry {
+	report := make([]Entry, 0, len(entries))
+	for _, entry := range entries {
+		report = append(report, rounding.Convert(entry))
+	}
+	SortByDate(report)
+	return report
+}
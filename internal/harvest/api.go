@@ -0,0 +1,24 @@
+package harvest
+
+import (
+	"context"
+	"time"
+)
+
+// API is the set of Harvest operations the TUI depends on. *Client implements
+// it against the real API; harvestfake.Client implements it in memory for
+// tests, demos and offline use.
+type API interface {
+	FetchProjectAssignmentsContext(ctx context.Context) ([]ProjectAssignment, error)
+	FetchTimeEntriesContext(ctx context.Context, date string) ([]TimeEntry, error)
+	FetchTimeEntriesRangeContext(ctx context.Context, from, to string) ([]TimeEntry, error)
+	FetchTimeEntryChangesContext(ctx context.Context, from, to string, since time.Time) (*TimeEntryChanges, error)
+	FetchRunningTimeEntryContext(ctx context.Context) (*TimeEntry, error)
+	CreateTimeEntryContext(ctx context.Context, request CreateTimeEntryRequest) (*TimeEntry, error)
+	UpdateTimeEntryContext(ctx context.Context, id int, request UpdateTimeEntryRequest) (*TimeEntry, error)
+	DeleteTimeEntryContext(ctx context.Context, id int) error
+	RestartTimeEntryContext(ctx context.Context, id int) (*TimeEntry, error)
+	StopTimeEntryContext(ctx context.Context, id int) (*TimeEntry, error)
+}
+
+var _ API = (*Client)(nil)
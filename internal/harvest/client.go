@@ -2,11 +2,14 @@ package harvest
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 	"io"
 	"net/http"
+	"net/url"
 	"sort"
+	"strconv"
 	"time"
 )
 
@@ -23,6 +26,17 @@ type User struct {
 	Email     string `json:"email"`
 }
 
+// Company holds the account-wide settings from GET /v2/company that decide how
+// times, dates and weeks are shown.
+type Company struct {
+	Name                 string `json:"name"`
+	WantsTimestampTimers bool   `json:"wants_timestamp_timers"`
+	TimeFormat           string `json:"time_format"`    // "decimal" or "hours_minutes"
+	Clock                string `json:"clock"`          // "12h" or "24h"
+	DateFormat           string `json:"date_format"`    // strftime layout, e.g. "%m/%d/%Y"
+	WeekStartDay         string `json:"week_start_day"` // "Saturday", "Sunday" or "Monday"
+}
+
 // ProjectClient represents a client associated with a project.
 type ProjectClient struct {
 	ID   int    `json:"id"`
@@ -77,6 +91,34 @@ type taskAssignmentsResponse struct {
 	NextPage        *int             `json:"next_page"`
 }
 
+// ProjectAssignmentProject represents project info within a project assignment.
+type ProjectAssignmentProject struct {
+	ID   int    `json:"id"`
+	Name string `json:"name"`
+	Code string `json:"code"`
+}
+
+// ProjectAssignment is one of the current user's projects, with its task
+// assignments embedded. Unlike /v2/projects, listing these needs no admin or
+// manager permissions.
+type ProjectAssignment struct {
+	ID              int                      `json:"id"`
+	IsActive        bool                     `json:"is_active"`
+	Project         ProjectAssignmentProject `json:"project"`
+	Client          ProjectClient            `json:"client"`
+	TaskAssignments []TaskAssignment         `json:"task_assignments"`
+}
+
+// projectAssignmentsResponse represents the paginated response from GET /v2/users/me/project_assignments.
+type projectAssignmentsResponse struct {
+	ProjectAssignments []ProjectAssignment `json:"project_assignments"`
+	PerPage            int                 `json:"per_page"`
+	TotalPages         int                 `json:"total_pages"`
+	TotalEntries       int                 `json:"total_entries"`
+	Page               int                 `json:"page"`
+	NextPage           *int                `json:"next_page"`
+}
+
 // TimeEntryClient represents client info within a time entry.
 type TimeEntryClient struct {
 	ID   int    `json:"id"`
@@ -95,18 +137,23 @@ type TimeEntryTask struct {
 	Name string `json:"name"`
 }
 
-// TimeEntry represents a time entry from the Harvest API.
+// TimeEntry represents a time entry from the Harvest API. StartedTime and
+// EndedTime are only set on accounts that track time via start and end times,
+// in Harvest's "8:00am" format; EndedTime is empty while the timer runs.
 type TimeEntry struct {
-	ID         int              `json:"id"`
-	SpentDate  string           `json:"spent_date"`
-	Hours      float64          `json:"hours"`
-	Notes      string           `json:"notes"`
-	IsRunning  bool             `json:"is_running"`
-	IsLocked   bool             `json:"is_locked"`
-	IsBillable bool             `json:"billable"`
-	Client     TimeEntryClient  `json:"client"`
-	Project    TimeEntryProject `json:"project"`
-	Task       TimeEntryTask    `json:"task"`
+	ID          int              `json:"id"`
+	SpentDate   string           `json:"spent_date"`
+	Hours       float64          `json:"hours"`
+	StartedTime string           `json:"started_time"`
+	EndedTime   string           `json:"ended_time"`
+	Notes       string           `json:"notes"`
+	IsRunning   bool             `json:"is_running"`
+	IsLocked    bool             `json:"is_locked"`
+	IsBillable  bool             `json:"billable"`
+	Client      TimeEntryClient  `json:"client"`
+	Project     TimeEntryProject `json:"project"`
+	Task        TimeEntryTask    `json:"task"`
+	UpdatedAt   time.Time        `json:"updated_at"`
 }
 
 // timeEntriesResponse represents the paginated response from GET /v2/time_entries.
@@ -121,34 +168,125 @@ type timeEntriesResponse struct {
 
 // Task represents a task available for time tracking.
 type Task struct {
-	ID   int
-	Name string
+	ID       int    `json:"id"`
+	Name     string `json:"name"`
+	Billable bool   `json:"billable"` // Default billable status from the task assignment
 }
 
 // ProjectWithTasks combines a project with its available tasks.
 type ProjectWithTasks struct {
-	Project Project
-	Tasks   []Task
+	Project Project `json:"project"`
+	Tasks   []Task  `json:"tasks"`
 }
 
 // CreateTimeEntryRequest represents the request payload for creating a time entry.
+// Leave Hours zero to start a running timer: Harvest only starts one when hours
+// is omitted, and stops any timer that was already running. StartedTime and
+// EndedTime ("8:00am") are used by accounts that track start and end times and
+// ignored by accounts that track durations.
 type CreateTimeEntryRequest struct {
-	ProjectID  int     `json:"project_id"`
-	TaskID     int     `json:"task_id"`
-	SpentDate  string  `json:"spent_date"`
-	Hours      float64 `json:"hours"`
-	Notes      string  `json:"notes"`
-	IsBillable *bool   `json:"billable,omitempty"`
+	ProjectID   int     `json:"project_id"`
+	TaskID      int     `json:"task_id"`
+	SpentDate   string  `json:"spent_date"`
+	Hours       float64 `json:"hours,omitempty"`
+	StartedTime string  `json:"started_time,omitempty"`
+	EndedTime   string  `json:"ended_time,omitempty"`
+	Notes       string  `json:"notes"`
+	IsBillable  *bool   `json:"billable,omitempty"`
 }
 
 // UpdateTimeEntryRequest represents the request payload for updating a time entry.
 type UpdateTimeEntryRequest struct {
-	ProjectID  *int     `json:"project_id,omitempty"`
-	TaskID     *int     `json:"task_id,omitempty"`
-	SpentDate  *string  `json:"spent_date,omitempty"`
-	Hours      *float64 `json:"hours,omitempty"`
-	Notes      *string  `json:"notes,omitempty"`
-	IsBillable *bool    `json:"billable,omitempty"`
+	ProjectID   *int     `json:"project_id,omitempty"`
+	TaskID      *int     `json:"task_id,omitempty"`
+	SpentDate   *string  `json:"spent_date,omitempty"`
+	Hours       *float64 `json:"hours,omitempty"`
+	StartedTime *string  `json:"started_time,omitempty"`
+	EndedTime   *string  `json:"ended_time,omitempty"`
+	Notes       *string  `json:"notes,omitempty"`
+	IsBillable  *bool    `json:"billable,omitempty"`
+}
+
+// TimeEntryFilter narrows the time entries returned by FetchTimeEntriesFiltered.
+// Zero-value fields are left out of the query.
+type TimeEntryFilter struct {
+	ProjectID    int
+	ClientID     int
+	TaskID       int
+	IsBillable   *bool // Applied locally; the API has no billable filter
+	IsRunning    *bool
+	UpdatedSince time.Time // Only entries updated at or after this time
+}
+
+// values converts the filter into Harvest query parameters.
+// IsBillable is not included because it is applied by matches instead.
+func (f TimeEntryFilter) values() url.Values {
+	params := url.Values{}
+	if f.ProjectID != 0 {
+		params.Set("project_id", strconv.Itoa(f.ProjectID))
+	}
+	if f.ClientID != 0 {
+		params.Set("client_id", strconv.Itoa(f.ClientID))
+	}
+	if f.TaskID != 0 {
+		params.Set("task_id", strconv.Itoa(f.TaskID))
+	}
+	if f.IsRunning != nil {
+		params.Set("is_running", strconv.FormatBool(*f.IsRunning))
+	}
+	if !f.UpdatedSince.IsZero() {
+		params.Set("updated_since", f.UpdatedSince.UTC().Format(time.RFC3339))
+	}
+	return params
+}
+
+// matches reports whether the entry passes the filters that are applied locally.
+func (f TimeEntryFilter) matches(entry TimeEntry) bool {
+	return f.IsBillable == nil || entry.IsBillable == *f.IsBillable
+}
+
+// TimeEntryChanges describes how the time entries in a date range changed
+// since an earlier fetch of the same range. See FetchTimeEntryChanges.
+type TimeEntryChanges struct {
+	Updated []TimeEntry // Entries in the range created or updated since then
+}
+
+// Merge applies the changes to entries from an earlier fetch of the same
+// range. Updated entries replace their old versions and new ones are added
+// first within their day, matching Harvest's newest-first order.
+//
+// Harvest does not report deletions, so entries deleted or moved out of the
+// range since are kept. Fetch the range in full now and then to drop them.
+func (c TimeEntryChanges) Merge(entries []TimeEntry) []TimeEntry {
+	updated := make(map[int]TimeEntry, len(c.Updated))
+	for _, entry := range c.Updated {
+		updated[entry.ID] = entry
+	}
+
+	existing := make(map[int]bool, len(entries))
+	for _, entry := range entries {
+		existing[entry.ID] = true
+	}
+	var added []TimeEntry
+	for _, entry := range c.Updated {
+		if !existing[entry.ID] {
+			added = append(added, entry)
+		}
+	}
+
+	merged := make([]TimeEntry, 0, len(added)+len(entries))
+	merged = append(merged, added...)
+	for _, entry := range entries {
+		if changed, ok := updated[entry.ID]; ok {
+			entry = changed
+		}
+		merged = append(merged, entry)
+	}
+	sort.SliceStable(merged, func(i, j int) bool {
+		return merged[i].SpentDate > merged[j].SpentDate
+	})
+
+	return merged
 }
 
 // AggregateProjectsWithTasks combines projects and task assignments into a sorted list.
@@ -158,8 +296,9 @@ func AggregateProjectsWithTasks(projects []Project, taskAssignments []TaskAssign
 	tasksByProject := make(map[int][]Task)
 	for _, ta := range taskAssignments {
 		tasksByProject[ta.Project.ID] = append(tasksByProject[ta.Project.ID], Task{
-			ID:   ta.Task.ID,
-			Name: ta.Task.Name,
+			ID:       ta.Task.ID,
+			Name:     ta.Task.Name,
+			Billable: ta.Billable,
 		})
 	}
 
@@ -185,6 +324,28 @@ func AggregateProjectsWithTasks(projects []Project, taskAssignments []TaskAssign
 	return result
 }
 
+// SplitProjectAssignments converts project assignments into the projects and
+// task assignments AggregateProjectsWithTasks takes. Inactive assignments are
+// left out, and each task assignment gets its project filled in.
+func SplitProjectAssignments(assignments []ProjectAssignment) ([]Project, []TaskAssignment) {
+	var projects []Project
+	var taskAssignments []TaskAssignment
+	for _, pa := range assignments {
+		if !pa.IsActive {
+			continue
+		}
+		projects = append(projects, Project{ID: pa.Project.ID, Name: pa.Project.Name, Client: pa.Client})
+		for _, ta := range pa.TaskAssignments {
+			if !ta.IsActive {
+				continue
+			}
+			ta.Project = TaskAssignmentProject{ID: pa.Project.ID, Name: pa.Project.Name}
+			taskAssignments = append(taskAssignments, ta)
+		}
+	}
+	return projects, taskAssignments
+}
+
 // Client is an HTTP client for the Harvest API v2.
 type Client struct {
 	baseURL     string
@@ -192,6 +353,11 @@ type Client struct {
 	accessToken string
 	httpClient  *http.Client
 	userID      int // ID of the authenticated user
+	retry       RetryPolicy
+	limiter     *rateLimiter // Shared by every request so bulk operations stay under Harvest's limit
+
+	// sleep waits between retries and for rate limit tokens; replaced in tests to avoid real delays
+	sleep func(ctx context.Context, d time.Duration) error
 }
 
 // NewClient creates a new Harvest API client with the given credentials.
@@ -203,7 +369,9 @@ func NewClient(accountID, accessToken string) *Client {
 		httpClient: &http.Client{
 			Timeout: 30 * time.Second,
 		},
-		userID: 0, // Will be set when ValidateAuth is called
+		userID:  0, // Will be set when ValidateAuth is called
+		limiter: newRateLimiter(DefaultRateLimitRequests, DefaultRateLimitWindow),
+		sleep:   sleepContext,
 	}
 }
 
@@ -217,6 +385,40 @@ func (c *Client) SetHTTPClient(client *http.Client) {
 	c.httpClient = client
 }
 
+// SetRetryPolicy sets how rate-limited and transient failures are retried.
+// Retrying is disabled until a policy is set.
+func (c *Client) SetRetryPolicy(policy RetryPolicy) {
+	c.retry = policy
+}
+
+// SetRateLimit replaces the client-side rate limit with one allowing requests
+// per window. Clients start with Harvest's documented limit.
+func (c *Client) SetRateLimit(requests int, window time.Duration) {
+	limiter := newRateLimiter(requests, window)
+	if c.limiter != nil {
+		limiter.onThrottle = c.limiter.onThrottle
+	}
+	c.limiter = limiter
+}
+
+// SetThrottleHandler registers fn to be called with true when requests start
+// waiting on the client-side rate limit and with false once they are all
+// released. fn is called synchronously on the requesting goroutine, outside
+// the limiter's lock, so it may block or check Throttled.
+func (c *Client) SetThrottleHandler(fn func(throttled bool)) {
+	if c.limiter == nil {
+		c.limiter = newRateLimiter(DefaultRateLimitRequests, DefaultRateLimitWindow)
+	}
+	c.limiter.mu.Lock()
+	defer c.limiter.mu.Unlock()
+	c.limiter.onThrottle = fn
+}
+
+// Throttled reports whether any request is waiting on the client-side rate limit.
+func (c *Client) Throttled() bool {
+	return c.limiter.throttled()
+}
+
 // SetUserID sets the user ID (useful for testing).
 func (c *Client) SetUserID(userID int) {
 	c.userID = userID
@@ -229,43 +431,100 @@ func (c *Client) GetUserID() int {
 
 // Get performs a GET request to the specified path.
 func (c *Client) Get(path string) (*http.Response, error) {
-	return c.doRequest(http.MethodGet, path, nil)
+	return c.GetContext(context.Background(), path)
+}
+
+// GetContext performs a GET request to the specified path, bound to ctx.
+func (c *Client) GetContext(ctx context.Context, path string) (*http.Response, error) {
+	return c.doRequest(ctx, http.MethodGet, path, nil)
 }
 
 // Post performs a POST request to the specified path with the given body.
 func (c *Client) Post(path string, body interface{}) (*http.Response, error) {
-	return c.doRequest(http.MethodPost, path, body)
+	return c.PostContext(context.Background(), path, body)
+}
+
+// PostContext performs a POST request to the specified path with the given body, bound to ctx.
+func (c *Client) PostContext(ctx context.Context, path string, body interface{}) (*http.Response, error) {
+	return c.doRequest(ctx, http.MethodPost, path, body)
 }
 
 // Patch performs a PATCH request to the specified path with the given body.
 func (c *Client) Patch(path string, body interface{}) (*http.Response, error) {
-	return c.doRequest(http.MethodPatch, path, body)
+	return c.PatchContext(context.Background(), path, body)
+}
+
+// PatchContext performs a PATCH request to the specified path with the given body, bound to ctx.
+func (c *Client) PatchContext(ctx context.Context, path string, body interface{}) (*http.Response, error) {
+	return c.doRequest(ctx, http.MethodPatch, path, body)
 }
 
 // Delete performs a DELETE request to the specified path.
 func (c *Client) Delete(path string) (*http.Response, error) {
-	return c.doRequest(http.MethodDelete, path, nil)
+	return c.DeleteContext(context.Background(), path)
 }
 
-// doRequest performs an HTTP request with the appropriate headers.
-func (c *Client) doRequest(method, path string, body interface{}) (*http.Response, error) {
-	url := c.baseURL + path
+// DeleteContext performs a DELETE request to the specified path, bound to ctx.
+func (c *Client) DeleteContext(ctx context.Context, path string) (*http.Response, error) {
+	return c.doRequest(ctx, http.MethodDelete, path, nil)
+}
 
-	var bodyReader io.Reader
+// doRequest performs an HTTP request with the appropriate headers, retrying
+// rate-limited and transient failures according to the client's retry policy.
+// Every attempt first waits for a token from the client-side rate limiter.
+// The request is aborted when ctx is cancelled or its deadline passes.
+func (c *Client) doRequest(ctx context.Context, method, path string, body interface{}) (*http.Response, error) {
+	var jsonData []byte
 	if body != nil {
-		jsonData, err := json.Marshal(body)
+		var err error
+		jsonData, err = json.Marshal(body)
 		if err != nil {
 			return nil, fmt.Errorf("failed to marshal request body: %w", err)
 		}
+	}
+
+	sleep := c.sleep
+	if sleep == nil {
+		sleep = sleepContext
+	}
+
+	for retry := 0; ; retry++ {
+		if err := c.limiter.wait(ctx, sleep); err != nil {
+			return nil, err
+		}
+		resp, err := c.doAttempt(ctx, method, path, jsonData, body != nil)
+		if ctx.Err() != nil || retry >= c.retry.MaxRetries || !shouldRetry(method, resp, err) {
+			return resp, err
+		}
+
+		wait, ok := c.retry.retryDelay(retry, resp)
+		if !ok {
+			return resp, err
+		}
+		if resp != nil {
+			discardBody(resp)
+		}
+		if sleepErr := sleep(ctx, wait); sleepErr != nil {
+			return nil, sleepErr
+		}
+	}
+}
+
+// doAttempt performs a single HTTP request attempt.
+func (c *Client) doAttempt(ctx context.Context, method, path string, jsonData []byte, hasBody bool) (*http.Response, error) {
+	url := c.baseURL + path
+
+	var bodyReader io.Reader
+	if hasBody {
 		bodyReader = bytes.NewReader(jsonData)
 	}
 
-	req, err := http.NewRequest(method, url, bodyReader)
+	req, err := http.NewRequestWithContext(ctx, method, url, bodyReader)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create request: %w", err)
 	}
 
-	c.setHeaders(req, body != nil)
+	c.setHeaders(req, hasBody)
 
 	return c.httpClient.Do(req)
 }
@@ -285,14 +544,19 @@ func (c *Client) setHeaders(req *http.Request, hasBody bool) {
 // Returns the authenticated user on success and stores the user ID.
 // API Reference: https://help.getharvest.com/api-v2/users-api/users/users/#retrieve-the-currently-authenticated-user
 func (c *Client) ValidateAuth() (*User, error) {
-	resp, err := c.Get("/v2/users/me")
+	return c.ValidateAuthContext(context.Background())
+}
+
+// ValidateAuthContext is like ValidateAuth but aborts the request when ctx is cancelled.
+func (c *Client) ValidateAuthContext(ctx context.Context) (*User, error) {
+	resp, err := c.GetContext(ctx, "/v2/users/me")
 	if err != nil {
 		return nil, fmt.Errorf("network request failed: %w", err)
 	}
 	defer resp.Body.Close()
 
 	if resp.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("authentication failed with status %d", resp.StatusCode)
+		return nil, newAPIError(resp, "authentication failed")
 	}
 
 	var user User
@@ -306,23 +570,66 @@ func (c *Client) ValidateAuth() (*User, error) {
 	return &user, nil
 }
 
+// userIDContext returns the current user's ID, looking it up first when
+// ValidateAuth has not been called, as in one-off commands.
+func (c *Client) userIDContext(ctx context.Context) (int, error) {
+	if c.userID == 0 {
+		if _, err := c.ValidateAuthContext(ctx); err != nil {
+			return 0, err
+		}
+	}
+	return c.userID, nil
+}
+
+// FetchCompany retrieves the account's company settings.
+// API Reference: https://help.getharvest.com/api-v2/company-api/company/company/
+func (c *Client) FetchCompany() (*Company, error) {
+	return c.FetchCompanyContext(context.Background())
+}
+
+// FetchCompanyContext is like FetchCompany but aborts the request when ctx is cancelled.
+func (c *Client) FetchCompanyContext(ctx context.Context) (*Company, error) {
+	resp, err := c.GetContext(ctx, "/v2/company")
+	if err != nil {
+		return nil, fmt.Errorf("network request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, newAPIError(resp, "failed to fetch company")
+	}
+
+	var company Company
+	if err := json.NewDecoder(resp.Body).Decode(&company); err != nil {
+		return nil, fmt.Errorf("failed to parse response: %w", err)
+	}
+
+	return &company, nil
+}
+
 // FetchProjects retrieves all active projects the user has access to.
 // Handles pagination automatically.
 // API Reference: https://help.getharvest.com/api-v2/projects-api/projects/projects/
 func (c *Client) FetchProjects() ([]Project, error) {
+	return c.FetchProjectsContext(context.Background())
+}
+
+// FetchProjectsContext is like FetchProjects but aborts the request when ctx is cancelled.
+func (c *Client) FetchProjectsContext(ctx context.Context) ([]Project, error) {
 	var allProjects []Project
 	page := 1
 
 	for {
 		path := fmt.Sprintf("/v2/projects?is_active=true&page=%d", page)
-		resp, err := c.Get(path)
+		resp, err := c.GetContext(ctx, path)
 		if err != nil {
 			return nil, fmt.Errorf("network request failed: %w", err)
 		}
 
 		if resp.StatusCode != http.StatusOK {
+			apiErr := newAPIError(resp, "failed to fetch projects")
 			resp.Body.Close()
-			return nil, fmt.Errorf("failed to fetch projects with status %d", resp.StatusCode)
+			return nil, apiErr
 		}
 
 		var projectsResp projectsResponse
@@ -348,19 +655,25 @@ func (c *Client) FetchProjects() ([]Project, error) {
 // Handles pagination automatically.
 // API Reference: https://help.getharvest.com/api-v2/projects-api/projects/task-assignments/
 func (c *Client) FetchTaskAssignments() ([]TaskAssignment, error) {
+	return c.FetchTaskAssignmentsContext(context.Background())
+}
+
+// FetchTaskAssignmentsContext is like FetchTaskAssignments but aborts the request when ctx is cancelled.
+func (c *Client) FetchTaskAssignmentsContext(ctx context.Context) ([]TaskAssignment, error) {
 	var allTaskAssignments []TaskAssignment
 	page := 1
 
 	for {
 		path := fmt.Sprintf("/v2/task_assignments?is_active=true&page=%d", page)
-		resp, err := c.Get(path)
+		resp, err := c.GetContext(ctx, path)
 		if err != nil {
 			return nil, fmt.Errorf("network request failed: %w", err)
 		}
 
 		if resp.StatusCode != http.StatusOK {
+			apiErr := newAPIError(resp, "failed to fetch task assignments")
 			resp.Body.Close()
-			return nil, fmt.Errorf("failed to fetch task assignments with status %d", resp.StatusCode)
+			return nil, apiErr
 		}
 
 		var taskAssignmentsResp taskAssignmentsResponse
@@ -382,25 +695,117 @@ func (c *Client) FetchTaskAssignments() ([]TaskAssignment, error) {
 	return allTaskAssignments, nil
 }
 
+// FetchProjectAssignments retrieves the current user's active project
+// assignments with their task assignments. It works for every role, unlike
+// FetchProjects and FetchTaskAssignments which need admin or manager access.
+// Handles pagination automatically.
+// API Reference: https://help.getharvest.com/api-v2/users-api/users/project-assignments/#list-active-project-assignments-for-the-currently-authenticated-user
+func (c *Client) FetchProjectAssignments() ([]ProjectAssignment, error) {
+	return c.FetchProjectAssignmentsContext(context.Background())
+}
+
+// FetchProjectAssignmentsContext is like FetchProjectAssignments but aborts the request when ctx is cancelled.
+func (c *Client) FetchProjectAssignmentsContext(ctx context.Context) ([]ProjectAssignment, error) {
+	var allAssignments []ProjectAssignment
+	page := 1
+
+	for {
+		path := fmt.Sprintf("/v2/users/me/project_assignments?page=%d", page)
+		resp, err := c.GetContext(ctx, path)
+		if err != nil {
+			return nil, fmt.Errorf("network request failed: %w", err)
+		}
+
+		if resp.StatusCode != http.StatusOK {
+			apiErr := newAPIError(resp, "failed to fetch project assignments")
+			resp.Body.Close()
+			return nil, apiErr
+		}
+
+		var assignmentsResp projectAssignmentsResponse
+		if err := json.NewDecoder(resp.Body).Decode(&assignmentsResp); err != nil {
+			resp.Body.Close()
+			return nil, fmt.Errorf("failed to parse response: %w", err)
+		}
+		resp.Body.Close()
+
+		allAssignments = append(allAssignments, assignmentsResp.ProjectAssignments...)
+
+		// Check for more pages
+		if assignmentsResp.NextPage == nil {
+			break
+		}
+		page = *assignmentsResp.NextPage
+	}
+
+	return allAssignments, nil
+}
+
 // FetchTimeEntries retrieves all time entries for a specific date.
 // The date parameter should be in YYYY-MM-DD format.
 // Handles pagination automatically.
 // API Reference: https://help.getharvest.com/api-v2/timesheets-api/timesheets/time-entries/
 func (c *Client) FetchTimeEntries(date string) ([]TimeEntry, error) {
+	return c.FetchTimeEntriesContext(context.Background(), date)
+}
+
+// FetchTimeEntriesContext is like FetchTimeEntries but aborts the request when ctx is cancelled.
+func (c *Client) FetchTimeEntriesContext(ctx context.Context, date string) ([]TimeEntry, error) {
+	return c.FetchTimeEntriesRangeContext(ctx, date, date)
+}
+
+// FetchTimeEntriesRange retrieves all time entries between from and to, inclusive.
+// Both dates should be in YYYY-MM-DD format.
+// Handles pagination automatically.
+// API Reference: https://help.getharvest.com/api-v2/timesheets-api/timesheets/time-entries/
+func (c *Client) FetchTimeEntriesRange(from, to string) ([]TimeEntry, error) {
+	return c.FetchTimeEntriesRangeContext(context.Background(), from, to)
+}
+
+// FetchTimeEntriesRangeContext is like FetchTimeEntriesRange but aborts the request when ctx is cancelled.
+func (c *Client) FetchTimeEntriesRangeContext(ctx context.Context, from, to string) ([]TimeEntry, error) {
+	return c.FetchTimeEntriesFilteredContext(ctx, from, to, TimeEntryFilter{})
+}
+
+// FetchTimeEntriesFiltered retrieves all time entries between from and to, inclusive,
+// narrowed by the given filter. Either date may be empty to leave that end of the range open.
+// Handles pagination automatically.
+// API Reference: https://help.getharvest.com/api-v2/timesheets-api/timesheets/time-entries/#list-all-time-entries
+func (c *Client) FetchTimeEntriesFiltered(from, to string, filter TimeEntryFilter) ([]TimeEntry, error) {
+	return c.FetchTimeEntriesFilteredContext(context.Background(), from, to, filter)
+}
+
+// FetchTimeEntriesFilteredContext is like FetchTimeEntriesFiltered but aborts the request when ctx is cancelled.
+func (c *Client) FetchTimeEntriesFilteredContext(ctx context.Context, from, to string, filter TimeEntryFilter) ([]TimeEntry, error) {
 	var allTimeEntries []TimeEntry
 	page := 1
 
+	params := filter.values()
+	if from != "" {
+		params.Set("from", from)
+	}
+	if to != "" {
+		params.Set("to", to)
+	}
+	// Filter by user_id to only get current user's entries
+	userID, err := c.userIDContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	params.Set("user_id", strconv.Itoa(userID))
+
 	for {
-		// Filter by user_id to only get current user's entries
-		path := fmt.Sprintf("/v2/time_entries?from=%s&to=%s&user_id=%d&page=%d", date, date, c.userID, page)
-		resp, err := c.Get(path)
+		params.Set("page", strconv.Itoa(page))
+		path := "/v2/time_entries?" + params.Encode()
+		resp, err := c.GetContext(ctx, path)
 		if err != nil {
 			return nil, fmt.Errorf("network request failed: %w", err)
 		}
 
 		if resp.StatusCode != http.StatusOK {
+			apiErr := newAPIError(resp, "failed to fetch time entries")
 			resp.Body.Close()
-			return nil, fmt.Errorf("failed to fetch time entries with status %d", resp.StatusCode)
+			return nil, apiErr
 		}
 
 		var timeEntriesResp timeEntriesResponse
@@ -410,7 +815,11 @@ func (c *Client) FetchTimeEntries(date string) ([]TimeEntry, error) {
 		}
 		resp.Body.Close()
 
-		allTimeEntries = append(allTimeEntries, timeEntriesResp.TimeEntries...)
+		for _, entry := range timeEntriesResp.TimeEntries {
+			if filter.matches(entry) {
+				allTimeEntries = append(allTimeEntries, entry)
+			}
+		}
 
 		// Check for more pages
 		if timeEntriesResp.NextPage == nil {
@@ -422,17 +831,61 @@ func (c *Client) FetchTimeEntries(date string) ([]TimeEntry, error) {
 	return allTimeEntries, nil
 }
 
+// FetchRunningTimeEntry returns the user's running timer, whatever date it
+// was started on, or nil when no timer is running.
+// API Reference: https://help.getharvest.com/api-v2/timesheets-api/timesheets/time-entries/#list-all-time-entries
+func (c *Client) FetchRunningTimeEntry() (*TimeEntry, error) {
+	return c.FetchRunningTimeEntryContext(context.Background())
+}
+
+// FetchRunningTimeEntryContext is like FetchRunningTimeEntry but aborts the request when ctx is cancelled.
+func (c *Client) FetchRunningTimeEntryContext(ctx context.Context) (*TimeEntry, error) {
+	running := true
+	entries, err := c.FetchTimeEntriesFilteredContext(ctx, "", "", TimeEntryFilter{IsRunning: &running})
+	if err != nil {
+		return nil, err
+	}
+	// Harvest runs at most one timer per user
+	if len(entries) == 0 {
+		return nil, nil
+	}
+	return &entries[0], nil
+}
+
+// FetchTimeEntryChanges retrieves the entries between from and to that were
+// created or updated since the given time, in a single request unless they
+// span several pages. Pass the result's Merge the entries fetched earlier to
+// bring them up to date without fetching the whole range again.
+// API Reference: https://help.getharvest.com/api-v2/timesheets-api/timesheets/time-entries/#list-all-time-entries
+func (c *Client) FetchTimeEntryChanges(from, to string, since time.Time) (*TimeEntryChanges, error) {
+	return c.FetchTimeEntryChangesContext(context.Background(), from, to, since)
+}
+
+// FetchTimeEntryChangesContext is like FetchTimeEntryChanges but aborts the request when ctx is cancelled.
+func (c *Client) FetchTimeEntryChangesContext(ctx context.Context, from, to string, since time.Time) (*TimeEntryChanges, error) {
+	updated, err := c.FetchTimeEntriesFilteredContext(ctx, from, to, TimeEntryFilter{UpdatedSince: since})
+	if err != nil {
+		return nil, err
+	}
+	return &TimeEntryChanges{Updated: updated}, nil
+}
+
 // CreateTimeEntry creates a new time entry in Harvest.
 // API Reference: https://help.getharvest.com/api-v2/timesheets-api/timesheets/time-entries/
 func (c *Client) CreateTimeEntry(request CreateTimeEntryRequest) (*TimeEntry, error) {
-	resp, err := c.Post("/v2/time_entries", request)
+	return c.CreateTimeEntryContext(context.Background(), request)
+}
+
+// CreateTimeEntryContext is like CreateTimeEntry but aborts the request when ctx is cancelled.
+func (c *Client) CreateTimeEntryContext(ctx context.Context, request CreateTimeEntryRequest) (*TimeEntry, error) {
+	resp, err := c.PostContext(ctx, "/v2/time_entries", request)
 	if err != nil {
 		return nil, fmt.Errorf("network request failed: %w", err)
 	}
 	defer resp.Body.Close()
 
 	if resp.StatusCode != http.StatusCreated {
-		return nil, fmt.Errorf("failed to create time entry with status %d", resp.StatusCode)
+		return nil, newAPIError(resp, "failed to create time entry")
 	}
 
 	var timeEntry TimeEntry
@@ -446,15 +899,20 @@ func (c *Client) CreateTimeEntry(request CreateTimeEntryRequest) (*TimeEntry, er
 // UpdateTimeEntry updates an existing time entry in Harvest.
 // API Reference: https://help.getharvest.com/api-v2/timesheets-api/timesheets/time-entries/
 func (c *Client) UpdateTimeEntry(id int, request UpdateTimeEntryRequest) (*TimeEntry, error) {
+	return c.UpdateTimeEntryContext(context.Background(), id, request)
+}
+
+// UpdateTimeEntryContext is like UpdateTimeEntry but aborts the request when ctx is cancelled.
+func (c *Client) UpdateTimeEntryContext(ctx context.Context, id int, request UpdateTimeEntryRequest) (*TimeEntry, error) {
 	path := fmt.Sprintf("/v2/time_entries/%d", id)
-	resp, err := c.Patch(path, request)
+	resp, err := c.PatchContext(ctx, path, request)
 	if err != nil {
 		return nil, fmt.Errorf("network request failed: %w", err)
 	}
 	defer resp.Body.Close()
 
 	if resp.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("failed to update time entry with status %d", resp.StatusCode)
+		return nil, newAPIError(resp, "failed to update time entry")
 	}
 
 	var timeEntry TimeEntry
@@ -468,15 +926,20 @@ func (c *Client) UpdateTimeEntry(id int, request UpdateTimeEntryRequest) (*TimeE
 // DeleteTimeEntry deletes an existing time entry in Harvest.
 // API Reference: https://help.getharvest.com/api-v2/timesheets-api/timesheets/time-entries/
 func (c *Client) DeleteTimeEntry(id int) error {
+	return c.DeleteTimeEntryContext(context.Background(), id)
+}
+
+// DeleteTimeEntryContext is like DeleteTimeEntry but aborts the request when ctx is cancelled.
+func (c *Client) DeleteTimeEntryContext(ctx context.Context, id int) error {
 	path := fmt.Sprintf("/v2/time_entries/%d", id)
-	resp, err := c.Delete(path)
+	resp, err := c.DeleteContext(ctx, path)
 	if err != nil {
 		return fmt.Errorf("network request failed: %w", err)
 	}
 	defer resp.Body.Close()
 
 	if resp.StatusCode != http.StatusOK {
-		return fmt.Errorf("failed to delete time entry with status %d", resp.StatusCode)
+		return newAPIError(resp, "failed to delete time entry")
 	}
 
 	return nil
@@ -485,15 +948,20 @@ func (c *Client) DeleteTimeEntry(id int) error {
 // RestartTimeEntry restarts (starts the timer for) an existing time entry in Harvest.
 // API Reference: https://help.getharvest.com/api-v2/timesheets-api/timesheets/time-entries/
 func (c *Client) RestartTimeEntry(id int) (*TimeEntry, error) {
+	return c.RestartTimeEntryContext(context.Background(), id)
+}
+
+// RestartTimeEntryContext is like RestartTimeEntry but aborts the request when ctx is cancelled.
+func (c *Client) RestartTimeEntryContext(ctx context.Context, id int) (*TimeEntry, error) {
 	path := fmt.Sprintf("/v2/time_entries/%d/restart", id)
-	resp, err := c.Patch(path, nil)
+	resp, err := c.PatchContext(ctx, path, nil)
 	if err != nil {
 		return nil, fmt.Errorf("network request failed: %w", err)
 	}
 	defer resp.Body.Close()
 
 	if resp.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("failed to restart time entry with status %d", resp.StatusCode)
+		return nil, newAPIError(resp, "failed to restart time entry")
 	}
 
 	var timeEntry TimeEntry
@@ -507,15 +975,20 @@ func (c *Client) RestartTimeEntry(id int) (*TimeEntry, error) {
 // StopTimeEntry stops the timer for an existing time entry in Harvest.
 // API Reference: https://help.getharvest.com/api-v2/timesheets-api/timesheets/time-entries/
 func (c *Client) StopTimeEntry(id int) (*TimeEntry, error) {
+	return c.StopTimeEntryContext(context.Background(), id)
+}
+
+// StopTimeEntryContext is like StopTimeEntry but aborts the request when ctx is cancelled.
+func (c *Client) StopTimeEntryContext(ctx context.Context, id int) (*TimeEntry, error) {
 	path := fmt.Sprintf("/v2/time_entries/%d/stop", id)
-	resp, err := c.Patch(path, nil)
+	resp, err := c.PatchContext(ctx, path, nil)
 	if err != nil {
 		return nil, fmt.Errorf("network request failed: %w", err)
 	}
 	defer resp.Body.Close()
 
 	if resp.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("failed to stop time entry with status %d", resp.StatusCode)
+		return nil, newAPIError(resp, "failed to stop time entry")
 	}
 
 	var timeEntry TimeEntry
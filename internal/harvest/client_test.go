@@ -1,7 +1,9 @@
 package harvest
 
 import (
+	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net/http"
 	"net/http/httptest"
@@ -10,6 +12,65 @@ import (
 	"time"
 )
 
+func TestFetchCompany(t *testing.T) {
+	t.Run("given company settings when FetchCompany called then returns them", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.URL.Path != "/v2/company" {
+				t.Errorf("expected path /v2/company, got %s", r.URL.Path)
+			}
+
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusOK)
+			json.NewEncoder(w).Encode(map[string]interface{}{
+				"name":                   "Planet Argon",
+				"wants_timestamp_timers": true,
+				"time_format":            "decimal",
+				"clock":                  "24h",
+				"date_format":            "%d/%m/%Y",
+				"week_start_day":         "Sunday",
+				"currency":               "USD",
+			})
+		}))
+		defer server.Close()
+
+		client := NewClient("12345", "test-token")
+		client.SetBaseURL(server.URL)
+
+		company, err := client.FetchCompany()
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+
+		want := Company{
+			Name:                 "Planet Argon",
+			WantsTimestampTimers: true,
+			TimeFormat:           "decimal",
+			Clock:                "24h",
+			DateFormat:           "%d/%m/%Y",
+			WeekStartDay:         "Sunday",
+		}
+		if *company != want {
+			t.Errorf("expected %+v, got %+v", want, *company)
+		}
+	})
+
+	t.Run("given server error when FetchCompany called then returns API error", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusForbidden)
+			json.NewEncoder(w).Encode(map[string]string{"message": "Forbidden"})
+		}))
+		defer server.Close()
+
+		client := NewClient("12345", "test-token")
+		client.SetBaseURL(server.URL)
+
+		_, err := client.FetchCompany()
+		if err == nil || !strings.Contains(err.Error(), "failed to fetch company") {
+			t.Errorf("expected fetch company error, got %v", err)
+		}
+	})
+}
+
 func TestValidateAuth(t *testing.T) {
 	t.Run("given valid credentials when ValidateAuth called then returns user info without error", func(t *testing.T) {
 		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
@@ -510,6 +571,130 @@ func TestFetchTaskAssignments(t *testing.T) {
 	})
 }
 
+func TestFetchProjectAssignments(t *testing.T) {
+	t.Run("given valid response when FetchProjectAssignments called then returns projects with embedded task assignments", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.URL.Path != "/v2/users/me/project_assignments" {
+				t.Errorf("expected path /v2/users/me/project_assignments, got %s", r.URL.Path)
+			}
+			if r.Method != http.MethodGet {
+				t.Errorf("expected method GET, got %s", r.Method)
+			}
+
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusOK)
+			json.NewEncoder(w).Encode(map[string]interface{}{
+				"project_assignments": []map[string]interface{}{
+					{
+						"id":        1,
+						"is_active": true,
+						"project":   map[string]interface{}{"id": 100, "name": "API Development", "code": "API"},
+						"client":    map[string]interface{}{"id": 10, "name": "Acme Corp"},
+						"task_assignments": []map[string]interface{}{
+							{"id": 11, "is_active": true, "billable": true, "task": map[string]interface{}{"id": 1000, "name": "Code Review"}},
+							{"id": 12, "is_active": true, "billable": false, "task": map[string]interface{}{"id": 1001, "name": "Meetings"}},
+						},
+					},
+				},
+				"per_page":      100,
+				"total_pages":   1,
+				"total_entries": 1,
+				"page":          1,
+			})
+		}))
+		defer server.Close()
+
+		client := NewClient("12345", "test-token")
+		client.SetBaseURL(server.URL)
+
+		assignments, err := client.FetchProjectAssignments()
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+
+		if len(assignments) != 1 {
+			t.Fatalf("expected 1 project assignment, got %d", len(assignments))
+		}
+		assignment := assignments[0]
+		if assignment.Project.ID != 100 || assignment.Project.Name != "API Development" {
+			t.Errorf("expected project 100 API Development, got %+v", assignment.Project)
+		}
+		if assignment.Client.Name != "Acme Corp" {
+			t.Errorf("expected client Acme Corp, got %s", assignment.Client.Name)
+		}
+		if len(assignment.TaskAssignments) != 2 {
+			t.Fatalf("expected 2 task assignments, got %d", len(assignment.TaskAssignments))
+		}
+		if assignment.TaskAssignments[1].Task.Name != "Meetings" || assignment.TaskAssignments[1].Billable {
+			t.Errorf("expected non-billable Meetings task, got %+v", assignment.TaskAssignments[1])
+		}
+	})
+
+	t.Run("given paginated response when FetchProjectAssignments called then fetches all pages", func(t *testing.T) {
+		requestCount := 0
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			requestCount++
+			page := r.URL.Query().Get("page")
+
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusOK)
+
+			if page == "" || page == "1" {
+				json.NewEncoder(w).Encode(map[string]interface{}{
+					"project_assignments": []map[string]interface{}{
+						{"id": 1, "is_active": true, "project": map[string]interface{}{"id": 1, "name": "P1"}},
+					},
+					"per_page":  1,
+					"page":      1,
+					"next_page": 2,
+				})
+			} else {
+				json.NewEncoder(w).Encode(map[string]interface{}{
+					"project_assignments": []map[string]interface{}{
+						{"id": 2, "is_active": true, "project": map[string]interface{}{"id": 2, "name": "P2"}},
+					},
+					"per_page": 1,
+					"page":     2,
+				})
+			}
+		}))
+		defer server.Close()
+
+		client := NewClient("12345", "test-token")
+		client.SetBaseURL(server.URL)
+
+		assignments, err := client.FetchProjectAssignments()
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+
+		if len(assignments) != 2 {
+			t.Errorf("expected 2 project assignments from pagination, got %d", len(assignments))
+		}
+		if requestCount != 2 {
+			t.Errorf("expected 2 requests for pagination, got %d", requestCount)
+		}
+	})
+
+	t.Run("given error response when FetchProjectAssignments called then returns API error", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusUnauthorized)
+		}))
+		defer server.Close()
+
+		client := NewClient("12345", "test-token")
+		client.SetBaseURL(server.URL)
+
+		_, err := client.FetchProjectAssignments()
+		if err == nil {
+			t.Fatal("expected error, got nil")
+		}
+		if !strings.Contains(err.Error(), "failed to fetch project assignments") {
+			t.Errorf("expected project assignments error, got %v", err)
+		}
+	})
+}
+
 func TestFetchTimeEntries(t *testing.T) {
 	t.Run("given valid response when FetchTimeEntries called then returns time entries for date", func(t *testing.T) {
 		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
@@ -734,6 +919,478 @@ func TestFetchTimeEntries(t *testing.T) {
 			t.Errorf("expected 2 requests for pagination, got %d", requestCount)
 		}
 	})
+
+	t.Run("given no user ID when FetchTimeEntries called then looks up the user once first", func(t *testing.T) {
+		var userRequests int
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			if r.URL.Path == "/v2/users/me" {
+				userRequests++
+				json.NewEncoder(w).Encode(map[string]interface{}{"id": 42, "first_name": "Ada"})
+				return
+			}
+			if r.URL.Query().Get("user_id") != "42" {
+				t.Errorf("expected user_id=42, got %s", r.URL.Query().Get("user_id"))
+			}
+			json.NewEncoder(w).Encode(map[string]interface{}{"time_entries": []interface{}{}, "page": 1})
+		}))
+		defer server.Close()
+
+		client := NewClient("12345", "test-token")
+		client.SetBaseURL(server.URL)
+
+		for range 2 {
+			if _, err := client.FetchTimeEntries("2025-01-15"); err != nil {
+				t.Fatalf("expected no error, got %v", err)
+			}
+		}
+
+		if userRequests != 1 {
+			t.Errorf("expected the user looked up once, got %d requests", userRequests)
+		}
+	})
+}
+
+func TestFetchTimeEntriesRange(t *testing.T) {
+	t.Run("given week range when FetchTimeEntriesRange called then requests from and to in a single query", func(t *testing.T) {
+		requestCount := 0
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			requestCount++
+			if r.URL.Query().Get("from") != "2025-01-13" {
+				t.Errorf("expected from=2025-01-13, got %s", r.URL.Query().Get("from"))
+			}
+			if r.URL.Query().Get("to") != "2025-01-19" {
+				t.Errorf("expected to=2025-01-19, got %s", r.URL.Query().Get("to"))
+			}
+			if r.URL.Query().Get("user_id") != "123" {
+				t.Errorf("expected user_id=123, got %s", r.URL.Query().Get("user_id"))
+			}
+
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusOK)
+			json.NewEncoder(w).Encode(map[string]interface{}{
+				"time_entries": []map[string]interface{}{
+					{"id": 1, "spent_date": "2025-01-13", "hours": 1.0, "client": map[string]interface{}{"id": 1, "name": "C1"}, "project": map[string]interface{}{"id": 1, "name": "P1"}, "task": map[string]interface{}{"id": 1, "name": "T1"}},
+					{"id": 2, "spent_date": "2025-01-17", "hours": 2.5, "client": map[string]interface{}{"id": 1, "name": "C1"}, "project": map[string]interface{}{"id": 1, "name": "P1"}, "task": map[string]interface{}{"id": 1, "name": "T1"}},
+				},
+				"per_page":      100,
+				"total_pages":   1,
+				"total_entries": 2,
+				"page":          1,
+			})
+		}))
+		defer server.Close()
+
+		client := NewClient("12345", "test-token")
+		client.SetBaseURL(server.URL)
+		client.SetUserID(123)
+
+		entries, err := client.FetchTimeEntriesRange("2025-01-13", "2025-01-19")
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+
+		if len(entries) != 2 {
+			t.Fatalf("expected 2 time entries, got %d", len(entries))
+		}
+		if entries[1].SpentDate != "2025-01-17" {
+			t.Errorf("expected second entry on 2025-01-17, got %s", entries[1].SpentDate)
+		}
+		if requestCount != 1 {
+			t.Errorf("expected 1 request for the whole range, got %d", requestCount)
+		}
+	})
+
+	t.Run("given server error when FetchTimeEntriesRange called then returns error", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusInternalServerError)
+		}))
+		defer server.Close()
+
+		client := NewClient("12345", "test-token")
+		client.SetBaseURL(server.URL)
+
+		entries, err := client.FetchTimeEntriesRange("2025-01-13", "2025-01-19")
+		if err == nil {
+			t.Fatal("expected error, got nil")
+		}
+		if entries != nil {
+			t.Errorf("expected nil entries, got %v", entries)
+		}
+		if !strings.Contains(err.Error(), "500") {
+			t.Errorf("expected status code in error, got: %s", err.Error())
+		}
+	})
+}
+
+func TestFetchTimeEntriesFiltered(t *testing.T) {
+	t.Run("given filters when FetchTimeEntriesFiltered called then sends them as query params", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			q := r.URL.Query()
+			expected := map[string]string{
+				"from":          "2025-01-01",
+				"to":            "2025-01-31",
+				"user_id":       "123",
+				"project_id":    "200",
+				"client_id":     "100",
+				"task_id":       "300",
+				"is_running":    "true",
+				"updated_since": "2025-01-15T10:30:00Z",
+				"page":          "1",
+			}
+			for param, want := range expected {
+				if got := q.Get(param); got != want {
+					t.Errorf("expected %s=%s, got %s", param, want, got)
+				}
+			}
+
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusOK)
+			json.NewEncoder(w).Encode(map[string]interface{}{
+				"time_entries":  []interface{}{},
+				"per_page":      100,
+				"total_pages":   1,
+				"total_entries": 0,
+				"page":          1,
+			})
+		}))
+		defer server.Close()
+
+		client := NewClient("12345", "test-token")
+		client.SetBaseURL(server.URL)
+		client.SetUserID(123)
+
+		running := true
+		updatedSince := time.Date(2025, 1, 15, 2, 30, 0, 0, time.FixedZone("PST", -8*60*60))
+		_, err := client.FetchTimeEntriesFiltered("2025-01-01", "2025-01-31", TimeEntryFilter{
+			ProjectID:    200,
+			ClientID:     100,
+			TaskID:       300,
+			IsRunning:    &running,
+			UpdatedSince: updatedSince,
+		})
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+	})
+
+	t.Run("given empty filter and open range when FetchTimeEntriesFiltered called then omits unset params", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			q := r.URL.Query()
+			for _, param := range []string{"from", "to", "project_id", "client_id", "task_id", "is_running", "updated_since", "billable"} {
+				if q.Has(param) {
+					t.Errorf("expected %s to be omitted, got %s", param, q.Get(param))
+				}
+			}
+
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusOK)
+			json.NewEncoder(w).Encode(map[string]interface{}{
+				"time_entries":  []interface{}{},
+				"per_page":      100,
+				"total_pages":   1,
+				"total_entries": 0,
+				"page":          1,
+			})
+		}))
+		defer server.Close()
+
+		client := NewClient("12345", "test-token")
+		client.SetBaseURL(server.URL)
+
+		if _, err := client.FetchTimeEntriesFiltered("", "", TimeEntryFilter{}); err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+	})
+
+	t.Run("given billable filter when FetchTimeEntriesFiltered called then returns only matching entries across pages", func(t *testing.T) {
+		requestCount := 0
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			requestCount++
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusOK)
+
+			if r.URL.Query().Get("page") == "1" {
+				json.NewEncoder(w).Encode(map[string]interface{}{
+					"time_entries": []map[string]interface{}{
+						{"id": 1, "spent_date": "2025-01-15", "hours": 1.0, "billable": true},
+						{"id": 2, "spent_date": "2025-01-15", "hours": 1.0, "billable": false},
+					},
+					"page":      1,
+					"next_page": 2,
+				})
+			} else {
+				json.NewEncoder(w).Encode(map[string]interface{}{
+					"time_entries": []map[string]interface{}{
+						{"id": 3, "spent_date": "2025-01-16", "hours": 1.0, "billable": true},
+					},
+					"page": 2,
+				})
+			}
+		}))
+		defer server.Close()
+
+		client := NewClient("12345", "test-token")
+		client.SetBaseURL(server.URL)
+		client.SetUserID(123)
+
+		billable := true
+		entries, err := client.FetchTimeEntriesFiltered("2025-01-15", "2025-01-16", TimeEntryFilter{IsBillable: &billable})
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+
+		if len(entries) != 2 {
+			t.Fatalf("expected 2 billable entries, got %d", len(entries))
+		}
+		if entries[0].ID != 1 || entries[1].ID != 3 {
+			t.Errorf("expected entries 1 and 3, got %d and %d", entries[0].ID, entries[1].ID)
+		}
+		if requestCount != 2 {
+			t.Errorf("expected 2 requests for pagination, got %d", requestCount)
+		}
+	})
+}
+
+func TestFetchRunningTimeEntry(t *testing.T) {
+	t.Run("given a running timer on another day when FetchRunningTimeEntry called then returns it", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			q := r.URL.Query()
+			if q.Get("is_running") != "true" {
+				t.Errorf("expected is_running=true, got %s", q.Get("is_running"))
+			}
+			if q.Has("from") || q.Has("to") {
+				t.Errorf("expected no date range, got %s", r.URL.RawQuery)
+			}
+
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusOK)
+			json.NewEncoder(w).Encode(map[string]interface{}{
+				"time_entries": []map[string]interface{}{
+					{"id": 7, "spent_date": "2025-01-14", "hours": 3.5, "is_running": true},
+				},
+				"page": 1,
+			})
+		}))
+		defer server.Close()
+
+		client := NewClient("12345", "test-token")
+		client.SetBaseURL(server.URL)
+		client.SetUserID(123)
+
+		entry, err := client.FetchRunningTimeEntry()
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		if entry == nil || entry.ID != 7 || entry.SpentDate != "2025-01-14" {
+			t.Errorf("expected running entry 7 from 2025-01-14, got %+v", entry)
+		}
+	})
+
+	t.Run("given no running timer when FetchRunningTimeEntry called then returns nil", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusOK)
+			json.NewEncoder(w).Encode(map[string]interface{}{"time_entries": []interface{}{}, "page": 1})
+		}))
+		defer server.Close()
+
+		client := NewClient("12345", "test-token")
+		client.SetBaseURL(server.URL)
+		client.SetUserID(123)
+
+		entry, err := client.FetchRunningTimeEntry()
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		if entry != nil {
+			t.Errorf("expected nil, got %+v", entry)
+		}
+	})
+}
+
+func TestFetchTimeEntryChanges(t *testing.T) {
+	for _, tt := range []struct {
+		name, from, to string
+	}{
+		{"day", "2025-01-15", "2025-01-15"},
+		{"week", "2025-01-13", "2025-01-19"},
+	} {
+		t.Run("given a "+tt.name+" range and time when FetchTimeEntryChanges called then fetches updated entries in one request", func(t *testing.T) {
+			var requests int
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				requests++
+				q := r.URL.Query()
+				if q.Get("from") != tt.from || q.Get("to") != tt.to || q.Get("user_id") != "123" {
+					t.Errorf("expected range and user filters, got %s", r.URL.RawQuery)
+				}
+				if got := q.Get("updated_since"); got != "2025-01-15T10:30:00Z" {
+					t.Errorf("expected updated_since=2025-01-15T10:30:00Z, got %s", got)
+				}
+
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(http.StatusOK)
+				json.NewEncoder(w).Encode(map[string]interface{}{
+					"time_entries": []map[string]interface{}{
+						{"id": 2, "spent_date": "2025-01-15", "hours": 2.0, "updated_at": "2025-01-15T10:45:00Z"},
+					},
+					"total_entries": 1,
+					"page":          1,
+				})
+			}))
+			defer server.Close()
+
+			client := NewClient("12345", "test-token")
+			client.SetBaseURL(server.URL)
+			client.SetUserID(123)
+
+			changes, err := client.FetchTimeEntryChanges(tt.from, tt.to, time.Date(2025, 1, 15, 10, 30, 0, 0, time.UTC))
+			if err != nil {
+				t.Fatalf("expected no error, got %v", err)
+			}
+
+			if requests != 1 {
+				t.Errorf("expected one request per sync, got %d", requests)
+			}
+			if len(changes.Updated) != 1 || changes.Updated[0].ID != 2 {
+				t.Fatalf("expected entry 2 updated, got %+v", changes.Updated)
+			}
+			if want := time.Date(2025, 1, 15, 10, 45, 0, 0, time.UTC); !changes.Updated[0].UpdatedAt.Equal(want) {
+				t.Errorf("expected updated_at %v, got %v", want, changes.Updated[0].UpdatedAt)
+			}
+		})
+	}
+
+	t.Run("given error response when FetchTimeEntryChanges called then returns API error", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusInternalServerError)
+		}))
+		defer server.Close()
+
+		client := NewClient("12345", "test-token")
+		client.SetBaseURL(server.URL)
+
+		_, err := client.FetchTimeEntryChanges("2025-01-15", "2025-01-15", time.Now())
+		var apiErr *APIError
+		if !errors.As(err, &apiErr) || apiErr.StatusCode != http.StatusInternalServerError {
+			t.Errorf("expected 500 API error, got %v", err)
+		}
+	})
+}
+
+func TestTimeEntryChangesMerge(t *testing.T) {
+	entries := []TimeEntry{
+		{ID: 3, SpentDate: "2025-01-15", Notes: "Review"},
+		{ID: 1, SpentDate: "2025-01-15", Notes: "Standup"},
+		{ID: 2, SpentDate: "2025-01-14", Notes: "Planning"},
+	}
+
+	t.Run("given updated and new entries when merged then replaces and adds them newest first", func(t *testing.T) {
+		changes := TimeEntryChanges{
+			Updated: []TimeEntry{
+				{ID: 1, SpentDate: "2025-01-15", Notes: "Standup (long)"},
+				{ID: 4, SpentDate: "2025-01-14", Notes: "Support"},
+			},
+		}
+
+		merged := changes.Merge(entries)
+
+		var got []int
+		for _, entry := range merged {
+			got = append(got, entry.ID)
+		}
+		if want := []int{3, 1, 4, 2}; fmt.Sprint(got) != fmt.Sprint(want) {
+			t.Errorf("expected order %v, got %v", want, got)
+		}
+		if merged[1].Notes != "Standup (long)" {
+			t.Errorf("expected updated notes, got %s", merged[1].Notes)
+		}
+		if entries[1].Notes != "Standup" {
+			t.Error("expected the original entries to be left alone")
+		}
+	})
+
+	t.Run("given no changes when merged then keeps entries as they were", func(t *testing.T) {
+		changes := TimeEntryChanges{}
+
+		merged := changes.Merge(entries)
+		if len(merged) != 3 || merged[0].ID != 3 {
+			t.Errorf("expected entries unchanged, got %+v", merged)
+		}
+	})
+}
+
+func TestContextCancellation(t *testing.T) {
+	t.Run("given cancelled context when FetchTimeEntriesContext called then aborts with context error", func(t *testing.T) {
+		release := make(chan struct{})
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			<-release
+			w.WriteHeader(http.StatusOK)
+		}))
+		defer server.Close()
+		defer close(release)
+
+		client := NewClient("12345", "test-token")
+		client.SetBaseURL(server.URL)
+
+		ctx, cancel := context.WithCancel(context.Background())
+		go func() {
+			time.Sleep(20 * time.Millisecond)
+			cancel()
+		}()
+
+		entries, err := client.FetchTimeEntriesContext(ctx, "2025-01-15")
+		if err == nil {
+			t.Fatal("expected error, got nil")
+		}
+		if entries != nil {
+			t.Errorf("expected nil entries, got %v", entries)
+		}
+		if !errors.Is(err, context.Canceled) {
+			t.Errorf("expected context.Canceled error, got: %v", err)
+		}
+	})
+
+	t.Run("given expired deadline when StopTimeEntryContext called then returns deadline error", func(t *testing.T) {
+		release := make(chan struct{})
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			<-release
+			w.WriteHeader(http.StatusOK)
+		}))
+		defer server.Close()
+		defer close(release)
+
+		client := NewClient("12345", "test-token")
+		client.SetBaseURL(server.URL)
+
+		ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+		defer cancel()
+
+		_, err := client.StopTimeEntryContext(ctx, 1)
+		if !errors.Is(err, context.DeadlineExceeded) {
+			t.Errorf("expected context.DeadlineExceeded error, got: %v", err)
+		}
+	})
+
+	t.Run("given live context when ValidateAuthContext called then behaves like ValidateAuth", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusOK)
+			json.NewEncoder(w).Encode(map[string]interface{}{"id": 42, "first_name": "Test"})
+		}))
+		defer server.Close()
+
+		client := NewClient("12345", "test-token")
+		client.SetBaseURL(server.URL)
+
+		user, err := client.ValidateAuthContext(context.Background())
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		if user.ID != 42 || client.GetUserID() != 42 {
+			t.Errorf("expected user ID 42 to be returned and stored, got %d and %d", user.ID, client.GetUserID())
+		}
+	})
 }
 
 func TestCreateTimeEntry(t *testing.T) {
@@ -881,6 +1538,77 @@ func TestCreateTimeEntry(t *testing.T) {
 		}
 	})
 
+	t.Run("given zero hours when CreateTimeEntry called then omits hours so Harvest starts a timer", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			var reqData map[string]interface{}
+			if err := json.NewDecoder(r.Body).Decode(&reqData); err != nil {
+				t.Fatalf("failed to decode request body: %v", err)
+			}
+
+			if _, ok := reqData["hours"]; ok {
+				t.Errorf("expected hours to be omitted, got %v", reqData["hours"])
+			}
+
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusCreated)
+			json.NewEncoder(w).Encode(map[string]interface{}{
+				"id":         1003,
+				"spent_date": "2025-01-15",
+				"hours":      0.0,
+				"is_running": true,
+			})
+		}))
+		defer server.Close()
+
+		client := NewClient("12345", "test-token")
+		client.SetBaseURL(server.URL)
+
+		created, err := client.CreateTimeEntry(CreateTimeEntryRequest{ProjectID: 100, TaskID: 200, SpentDate: "2025-01-15"})
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+
+		if !created.IsRunning {
+			t.Error("expected created entry to be running")
+		}
+	})
+
+	t.Run("given start and end times when CreateTimeEntry called then sends and returns them", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			var reqData map[string]interface{}
+			if err := json.NewDecoder(r.Body).Decode(&reqData); err != nil {
+				t.Fatalf("failed to decode request body: %v", err)
+			}
+
+			if reqData["started_time"] != "9:15am" || reqData["ended_time"] != "10:45am" {
+				t.Errorf("expected started_time 9:15am and ended_time 10:45am, got %v and %v", reqData["started_time"], reqData["ended_time"])
+			}
+
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusCreated)
+			json.NewEncoder(w).Encode(map[string]interface{}{
+				"id":           1004,
+				"spent_date":   "2025-01-15",
+				"hours":        1.5,
+				"started_time": "9:15am",
+				"ended_time":   "10:45am",
+			})
+		}))
+		defer server.Close()
+
+		client := NewClient("12345", "test-token")
+		client.SetBaseURL(server.URL)
+
+		created, err := client.CreateTimeEntry(CreateTimeEntryRequest{ProjectID: 100, TaskID: 200, SpentDate: "2025-01-15", StartedTime: "9:15am", EndedTime: "10:45am"})
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+
+		if created.StartedTime != "9:15am" || created.EndedTime != "10:45am" {
+			t.Errorf("expected times to be decoded, got %q and %q", created.StartedTime, created.EndedTime)
+		}
+	})
+
 	t.Run("given invalid request when CreateTimeEntry called then returns error", func(t *testing.T) {
 		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 			w.Header().Set("Content-Type", "application/json")
@@ -1559,6 +2287,23 @@ func TestStopTimeEntry(t *testing.T) {
 }
 
 func TestAggregateProjectsWithTasks(t *testing.T) {
+	t.Run("given billable and non-billable assignments when aggregated then tasks carry the billable default", func(t *testing.T) {
+		projects := []Project{{ID: 1, Name: "Internal", Client: ProjectClient{ID: 100, Name: "Acme Corp"}}}
+		taskAssignments := []TaskAssignment{
+			{ID: 1, Project: TaskAssignmentProject{ID: 1}, Task: TaskAssignmentTask{ID: 10, Name: "Development"}, Billable: true},
+			{ID: 2, Project: TaskAssignmentProject{ID: 1}, Task: TaskAssignmentTask{ID: 11, Name: "Admin"}, Billable: false},
+		}
+
+		result := AggregateProjectsWithTasks(projects, taskAssignments)
+
+		if len(result) != 1 || len(result[0].Tasks) != 2 {
+			t.Fatalf("expected 1 project with 2 tasks, got %+v", result)
+		}
+		if !result[0].Tasks[0].Billable || result[0].Tasks[1].Billable {
+			t.Errorf("expected Development billable and Admin non-billable, got %+v", result[0].Tasks)
+		}
+	})
+
 	t.Run("given projects and task assignments when aggregated then returns projects with their tasks", func(t *testing.T) {
 		projects := []Project{
 			{ID: 1, Name: "API Development", Client: ProjectClient{ID: 100, Name: "Acme Corp"}},
@@ -1675,3 +2420,40 @@ func TestAggregateProjectsWithTasks(t *testing.T) {
 		}
 	})
 }
+
+func TestSplitProjectAssignments(t *testing.T) {
+	t.Run("given project assignments when split then aggregates into projects with their tasks", func(t *testing.T) {
+		assignments := []ProjectAssignment{
+			{
+				ID:       1,
+				IsActive: true,
+				Project:  ProjectAssignmentProject{ID: 1, Name: "API Development"},
+				Client:   ProjectClient{ID: 100, Name: "Acme Corp"},
+				TaskAssignments: []TaskAssignment{
+					{ID: 11, IsActive: true, Billable: true, Task: TaskAssignmentTask{ID: 10, Name: "Development"}},
+					{ID: 12, IsActive: false, Task: TaskAssignmentTask{ID: 11, Name: "Retired"}},
+				},
+			},
+			{
+				ID:              2,
+				IsActive:        false,
+				Project:         ProjectAssignmentProject{ID: 2, Name: "Archived"},
+				Client:          ProjectClient{ID: 100, Name: "Acme Corp"},
+				TaskAssignments: []TaskAssignment{{ID: 21, IsActive: true, Task: TaskAssignmentTask{ID: 10, Name: "Development"}}},
+			},
+		}
+
+		projects, taskAssignments := SplitProjectAssignments(assignments)
+		result := AggregateProjectsWithTasks(projects, taskAssignments)
+
+		if len(result) != 1 {
+			t.Fatalf("expected 1 active project, got %d", len(result))
+		}
+		if result[0].Project.Client.Name != "Acme Corp" || result[0].Project.Name != "API Development" {
+			t.Errorf("expected Acme Corp API Development, got %+v", result[0].Project)
+		}
+		if len(result[0].Tasks) != 1 || result[0].Tasks[0].Name != "Development" || !result[0].Tasks[0].Billable {
+			t.Errorf("expected only the active billable Development task, got %+v", result[0].Tasks)
+		}
+	})
+}
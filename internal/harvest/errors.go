@@ -0,0 +1,133 @@
+package harvest
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// maxErrorBodySize caps how much of an error response body is read.
+const maxErrorBodySize = 64 * 1024
+
+// APIError is returned when the Harvest API responds with an unexpected status code.
+// Use errors.As to inspect it and the Is* helpers to tell failure kinds apart.
+type APIError struct {
+	StatusCode int
+	Message    string // Human-readable message from the response body, if any
+	Method     string
+	Path       string
+	RetryAfter time.Duration // Parsed from the Retry-After header, zero if absent
+
+	summary string // What the client was trying to do, e.g. "failed to fetch projects"
+}
+
+// errorBody covers the error payload shapes returned by the Harvest API.
+type errorBody struct {
+	Message          string `json:"message"`
+	Error            string `json:"error"`
+	ErrorDescription string `json:"error_description"`
+}
+
+// newAPIError builds an APIError from a non-success response, reading its body.
+// The caller remains responsible for closing the response body.
+func newAPIError(resp *http.Response, summary string) *APIError {
+	apiErr := &APIError{
+		StatusCode: resp.StatusCode,
+		RetryAfter: parseRetryAfter(resp.Header.Get("Retry-After"), time.Now()),
+		summary:    summary,
+	}
+	if resp.Request != nil && resp.Request.URL != nil {
+		apiErr.Method = resp.Request.Method
+		apiErr.Path = resp.Request.URL.Path
+	}
+
+	data, err := io.ReadAll(io.LimitReader(resp.Body, maxErrorBodySize))
+	if err != nil || len(data) == 0 {
+		return apiErr
+	}
+
+	var body errorBody
+	if err := json.Unmarshal(data, &body); err == nil {
+		switch {
+		case body.Message != "":
+			apiErr.Message = body.Message
+		case body.ErrorDescription != "":
+			apiErr.Message = body.ErrorDescription
+		case body.Error != "":
+			apiErr.Message = body.Error
+		}
+	}
+
+	return apiErr
+}
+
+// NewAPIError returns an APIError as if Harvest had rejected a request with
+// status and message. It lets fakes reproduce API failures faithfully.
+func NewAPIError(summary string, status int, message string) *APIError {
+	return &APIError{StatusCode: status, Message: message, summary: summary}
+}
+
+// Error returns the failure summary, status code and Harvest's message when present.
+func (e *APIError) Error() string {
+	msg := fmt.Sprintf("%s with status %d", e.summary, e.StatusCode)
+	if e.Message != "" {
+		msg += ": " + e.Message
+	}
+	return msg
+}
+
+// Summary returns what the client was trying to do, e.g. "failed to fetch projects".
+func (e *APIError) Summary() string {
+	return e.summary
+}
+
+// IsUnauthorized reports whether the credentials were rejected or lack permission (401/403).
+func (e *APIError) IsUnauthorized() bool {
+	return e.StatusCode == http.StatusUnauthorized || e.StatusCode == http.StatusForbidden
+}
+
+// IsNotFound reports whether the requested resource does not exist (404).
+func (e *APIError) IsNotFound() bool {
+	return e.StatusCode == http.StatusNotFound
+}
+
+// IsValidation reports whether Harvest rejected the request payload (422),
+// for example when editing an approved or locked entry.
+func (e *APIError) IsValidation() bool {
+	return e.StatusCode == http.StatusUnprocessableEntity
+}
+
+// IsRateLimited reports whether the request was throttled (429).
+func (e *APIError) IsRateLimited() bool {
+	return e.StatusCode == http.StatusTooManyRequests
+}
+
+// IsServerError reports whether Harvest failed to handle the request (5xx).
+func (e *APIError) IsServerError() bool {
+	return e.StatusCode >= 500
+}
+
+// parseRetryAfter parses a Retry-After header given either in seconds or as an HTTP date.
+// Returns zero when the header is absent or unparseable.
+func parseRetryAfter(value string, now time.Time) time.Duration {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return 0
+	}
+	if seconds, err := strconv.Atoi(value); err == nil {
+		if seconds < 0 {
+			return 0
+		}
+		return time.Duration(seconds) * time.Second
+	}
+	if date, err := http.ParseTime(value); err == nil {
+		if wait := date.Sub(now); wait > 0 {
+			return wait
+		}
+	}
+	return 0
+}
@@ -0,0 +1,156 @@
+package harvest
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestAPIError(t *testing.T) {
+	t.Run("given 422 with message body when UpdateTimeEntry called then returns APIError with Harvest message", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusUnprocessableEntity)
+			w.Write([]byte(`{"message": "Cannot edit approved entry"}`))
+		}))
+		defer server.Close()
+
+		client := NewClient("12345", "test-token")
+		client.SetBaseURL(server.URL)
+
+		notes := "updated"
+		_, err := client.UpdateTimeEntry(42, UpdateTimeEntryRequest{Notes: &notes})
+
+		var apiErr *APIError
+		if !errors.As(err, &apiErr) {
+			t.Fatalf("expected *APIError, got %T: %v", err, err)
+		}
+		if apiErr.StatusCode != http.StatusUnprocessableEntity {
+			t.Errorf("expected status 422, got %d", apiErr.StatusCode)
+		}
+		if apiErr.Message != "Cannot edit approved entry" {
+			t.Errorf("expected Harvest message, got %q", apiErr.Message)
+		}
+		if apiErr.Method != http.MethodPatch {
+			t.Errorf("expected method PATCH, got %s", apiErr.Method)
+		}
+		if apiErr.Path != "/v2/time_entries/42" {
+			t.Errorf("expected path /v2/time_entries/42, got %s", apiErr.Path)
+		}
+		if !apiErr.IsValidation() {
+			t.Error("expected IsValidation to be true")
+		}
+		if err.Error() != "failed to update time entry with status 422: Cannot edit approved entry" {
+			t.Errorf("unexpected error string: %s", err.Error())
+		}
+	})
+
+	t.Run("given 401 with oauth error body when ValidateAuth called then uses error description", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusUnauthorized)
+			w.Write([]byte(`{"error": "invalid_token", "error_description": "The access token is invalid"}`))
+		}))
+		defer server.Close()
+
+		client := NewClient("12345", "bad-token")
+		client.SetBaseURL(server.URL)
+
+		_, err := client.ValidateAuth()
+
+		var apiErr *APIError
+		if !errors.As(err, &apiErr) {
+			t.Fatalf("expected *APIError, got %T: %v", err, err)
+		}
+		if !apiErr.IsUnauthorized() {
+			t.Error("expected IsUnauthorized to be true")
+		}
+		if apiErr.Message != "The access token is invalid" {
+			t.Errorf("expected error description, got %q", apiErr.Message)
+		}
+		if !strings.HasPrefix(err.Error(), "authentication failed with status 401") {
+			t.Errorf("unexpected error string: %s", err.Error())
+		}
+	})
+
+	t.Run("given 429 with Retry-After when paginated fetch fails then reports rate limiting and wait time", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Retry-After", "7")
+			w.WriteHeader(http.StatusTooManyRequests)
+		}))
+		defer server.Close()
+
+		client := NewClient("12345", "test-token")
+		client.SetBaseURL(server.URL)
+
+		_, err := client.FetchProjects()
+
+		var apiErr *APIError
+		if !errors.As(err, &apiErr) {
+			t.Fatalf("expected *APIError, got %T: %v", err, err)
+		}
+		if !apiErr.IsRateLimited() {
+			t.Error("expected IsRateLimited to be true")
+		}
+		if apiErr.RetryAfter != 7*time.Second {
+			t.Errorf("expected RetryAfter 7s, got %v", apiErr.RetryAfter)
+		}
+		if apiErr.Message != "" {
+			t.Errorf("expected empty message for empty body, got %q", apiErr.Message)
+		}
+	})
+
+	t.Run("given 404 with non-JSON body when DeleteTimeEntry called then returns not found without message", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusNotFound)
+			w.Write([]byte("<html>Not Found</html>"))
+		}))
+		defer server.Close()
+
+		client := NewClient("12345", "test-token")
+		client.SetBaseURL(server.URL)
+
+		err := client.DeleteTimeEntry(99)
+
+		var apiErr *APIError
+		if !errors.As(err, &apiErr) {
+			t.Fatalf("expected *APIError, got %T: %v", err, err)
+		}
+		if !apiErr.IsNotFound() {
+			t.Error("expected IsNotFound to be true")
+		}
+		if apiErr.IsValidation() || apiErr.IsRateLimited() || apiErr.IsUnauthorized() || apiErr.IsServerError() {
+			t.Error("expected only IsNotFound to be true")
+		}
+		if apiErr.Message != "" {
+			t.Errorf("expected no message for HTML body, got %q", apiErr.Message)
+		}
+	})
+}
+
+func TestParseRetryAfter(t *testing.T) {
+	now := time.Date(2025, 1, 15, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name  string
+		value string
+		want  time.Duration
+	}{
+		{name: "empty", value: "", want: 0},
+		{name: "seconds", value: "15", want: 15 * time.Second},
+		{name: "negative seconds", value: "-3", want: 0},
+		{name: "http date in future", value: "Wed, 15 Jan 2025 12:00:30 GMT", want: 30 * time.Second},
+		{name: "http date in past", value: "Wed, 15 Jan 2025 11:59:00 GMT", want: 0},
+		{name: "garbage", value: "soon", want: 0},
+	}
+
+	for _, tt := range tests {
+		t.Run("given "+tt.name+" when parsed then returns expected wait", func(t *testing.T) {
+			if got := parseRetryAfter(tt.value, now); got != tt.want {
+				t.Errorf("expected %v, got %v", tt.want, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,67 @@
+package harvestfake
+
+import (
+	"context"
+	"time"
+
+	"github.com/planetargon/harvest-tui/internal/harvest"
+)
+
+// NewDemo returns a client seeded with a few clients, projects and a week of
+// time entries ending on today, including one running timer. It backs the
+// --fake-server mode so the app can be tried without a Harvest account.
+func NewDemo(today time.Time) *Client {
+	c := New()
+
+	acme := harvest.ProjectClient{ID: 1, Name: "Acme Corp"}
+	globex := harvest.ProjectClient{ID: 2, Name: "Globex"}
+	internal := harvest.ProjectClient{ID: 3, Name: "Planet Argon"}
+
+	development := harvest.Task{ID: 100, Name: "Development", Billable: true}
+	design := harvest.Task{ID: 101, Name: "Design", Billable: true}
+	meetings := harvest.Task{ID: 102, Name: "Meetings", Billable: true}
+	support := harvest.Task{ID: 103, Name: "Support", Billable: true}
+
+	// Internal work is tracked but never billed
+	internalMeetings := harvest.Task{ID: 102, Name: "Meetings"}
+	internalSupport := harvest.Task{ID: 103, Name: "Support"}
+
+	c.AddProject(harvest.Project{ID: 10, Name: "Website Redesign", Client: acme}, development, design, meetings)
+	c.AddProject(harvest.Project{ID: 11, Name: "Maintenance", Client: acme}, development, support)
+	c.AddProject(harvest.Project{ID: 20, Name: "Mobile App", Client: globex}, development, design)
+	c.AddProject(harvest.Project{ID: 30, Name: "Internal", Client: internal}, internalMeetings, internalSupport)
+
+	type demoEntry struct {
+		daysAgo   int
+		projectID int
+		taskID    int
+		hours     float64
+		notes     string
+	}
+	entries := []demoEntry{
+		{6, 20, 101, 3.0, "Onboarding flow mockups"},
+		{5, 10, 100, 4.5, "Navigation refactor"},
+		{5, 30, 102, 1.0, "Weekly planning"},
+		{4, 11, 103, 2.25, "Investigate checkout errors"},
+		{3, 20, 100, 5.0, "Push notification spike"},
+		{2, 10, 102, 0.75, "Client sync"},
+		{1, 10, 100, 6.0, "Homepage components"},
+		{0, 30, 102, 0.5, "Standup"},
+		{0, 10, 100, 1.25, "Accessibility fixes"},
+	}
+	for _, e := range entries {
+		c.CreateTimeEntryContext(context.Background(), harvest.CreateTimeEntryRequest{
+			ProjectID: e.projectID,
+			TaskID:    e.taskID,
+			SpentDate: today.AddDate(0, 0, -e.daysAgo).Format("2006-01-02"),
+			Hours:     e.hours,
+			Notes:     e.notes,
+		})
+	}
+
+	// Keep the most recent entry running so timer features can be tried out
+	last := c.entries[len(c.entries)-1]
+	c.RestartTimeEntryContext(context.Background(), last.ID)
+
+	return c
+}
@@ -0,0 +1,440 @@
+// Package harvestfake provides an in-memory implementation of harvest.API for
+// tests, demos and offline use. It mirrors the Harvest behaviors the TUI relies
+// on: names are filled in from projects and tasks, restarting a timer stops
+// any other running timer, and locked or missing entries are rejected with
+// the same *harvest.APIError kinds the real client returns.
+package harvestfake
+
+import (
+	"context"
+	"net/http"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/planetargon/harvest-tui/internal/harvest"
+)
+
+// Client is an in-memory harvest.API. The zero value is not usable; create
+// one with New. It is safe for concurrent use.
+type Client struct {
+	mu              sync.Mutex
+	projects        []harvest.Project
+	taskAssignments []harvest.TaskAssignment
+	entries         []harvest.TimeEntry
+	nextID          int
+	err             error
+}
+
+var _ harvest.API = (*Client)(nil)
+
+// New returns an empty fake client.
+func New() *Client {
+	return &Client{nextID: 1}
+}
+
+// AddProject registers a project and assigns the given tasks to it. Each
+// task's Billable flag becomes the billable default of its assignment.
+func (c *Client) AddProject(project harvest.Project, tasks ...harvest.Task) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.projects = append(c.projects, project)
+	for _, task := range tasks {
+		c.taskAssignments = append(c.taskAssignments, harvest.TaskAssignment{
+			ID:       len(c.taskAssignments) + 1,
+			Project:  harvest.TaskAssignmentProject{ID: project.ID, Name: project.Name},
+			Task:     harvest.TaskAssignmentTask{ID: task.ID, Name: task.Name},
+			IsActive: true,
+			Billable: task.Billable,
+		})
+	}
+}
+
+// AddTimeEntry stores entry as-is, assigning an ID and update time when it
+// has none, and returns the stored entry.
+func (c *Client) AddTimeEntry(entry harvest.TimeEntry) harvest.TimeEntry {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if entry.ID == 0 {
+		entry.ID = c.nextID
+	}
+	if entry.ID >= c.nextID {
+		c.nextID = entry.ID + 1
+	}
+	if entry.UpdatedAt.IsZero() {
+		entry.UpdatedAt = time.Now()
+	}
+	c.entries = append(c.entries, entry)
+	return entry
+}
+
+// TimeEntries returns a copy of every stored time entry in insertion order.
+func (c *Client) TimeEntries() []harvest.TimeEntry {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return append([]harvest.TimeEntry(nil), c.entries...)
+}
+
+// SetError makes every subsequent call fail with err. Pass nil to recover.
+func (c *Client) SetError(err error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.err = err
+}
+
+// FetchProjectsContext returns the registered projects, for harvesttest's
+// admin endpoints; the app itself lists project assignments.
+func (c *Client) FetchProjectsContext(ctx context.Context) ([]harvest.Project, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if err := c.check(ctx); err != nil {
+		return nil, err
+	}
+	return append([]harvest.Project(nil), c.projects...), nil
+}
+
+// FetchTaskAssignmentsContext returns the task assignments created by
+// AddProject, for harvesttest's admin endpoints.
+func (c *Client) FetchTaskAssignmentsContext(ctx context.Context) ([]harvest.TaskAssignment, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if err := c.check(ctx); err != nil {
+		return nil, err
+	}
+	return append([]harvest.TaskAssignment(nil), c.taskAssignments...), nil
+}
+
+// FetchProjectAssignmentsContext returns the projects added with AddProject
+// as the current user's project assignments, with their tasks embedded.
+func (c *Client) FetchProjectAssignmentsContext(ctx context.Context) ([]harvest.ProjectAssignment, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if err := c.check(ctx); err != nil {
+		return nil, err
+	}
+	assignments := make([]harvest.ProjectAssignment, 0, len(c.projects))
+	for i, project := range c.projects {
+		assignment := harvest.ProjectAssignment{
+			ID:       i + 1,
+			IsActive: true,
+			Project:  harvest.ProjectAssignmentProject{ID: project.ID, Name: project.Name},
+			Client:   project.Client,
+		}
+		for _, ta := range c.taskAssignments {
+			if ta.Project.ID == project.ID {
+				// Embedded task assignments carry no project of their own
+				ta.Project = harvest.TaskAssignmentProject{}
+				assignment.TaskAssignments = append(assignment.TaskAssignments, ta)
+			}
+		}
+		assignments = append(assignments, assignment)
+	}
+	return assignments, nil
+}
+
+// FetchTimeEntriesContext returns the entries spent on date.
+func (c *Client) FetchTimeEntriesContext(ctx context.Context, date string) ([]harvest.TimeEntry, error) {
+	return c.FetchTimeEntriesRangeContext(ctx, date, date)
+}
+
+// FetchTimeEntriesRangeContext returns the entries spent between from and to,
+// inclusive, newest first like the Harvest API. Empty bounds are open-ended.
+func (c *Client) FetchTimeEntriesRangeContext(ctx context.Context, from, to string) ([]harvest.TimeEntry, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if err := c.check(ctx); err != nil {
+		return nil, err
+	}
+
+	entries := []harvest.TimeEntry{}
+	for _, entry := range c.entries {
+		if (from == "" || entry.SpentDate >= from) && (to == "" || entry.SpentDate <= to) {
+			entries = append(entries, entry)
+		}
+	}
+	sort.SliceStable(entries, func(i, j int) bool {
+		if entries[i].SpentDate != entries[j].SpentDate {
+			return entries[i].SpentDate > entries[j].SpentDate
+		}
+		return entries[i].ID > entries[j].ID
+	})
+	return entries, nil
+}
+
+// FetchTimeEntryChangesContext returns the entries spent between from and to
+// that were created or updated at or after since.
+func (c *Client) FetchTimeEntryChangesContext(ctx context.Context, from, to string, since time.Time) (*harvest.TimeEntryChanges, error) {
+	entries, err := c.FetchTimeEntriesRangeContext(ctx, from, to)
+	if err != nil {
+		return nil, err
+	}
+
+	changes := &harvest.TimeEntryChanges{}
+	for _, entry := range entries {
+		if !entry.UpdatedAt.Before(since) {
+			changes.Updated = append(changes.Updated, entry)
+		}
+	}
+	return changes, nil
+}
+
+// FetchRunningTimeEntryContext returns the running entry on any date, or nil.
+func (c *Client) FetchRunningTimeEntryContext(ctx context.Context) (*harvest.TimeEntry, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if err := c.check(ctx); err != nil {
+		return nil, err
+	}
+	for _, entry := range c.entries {
+		if entry.IsRunning {
+			return &entry, nil
+		}
+	}
+	return nil, nil
+}
+
+// CreateTimeEntryContext stores a new entry for an assigned project and task.
+// Hours default to the span between the start and end times when both are
+// given. Zero hours without an end time starts a running timer and stops any
+// other, as Harvest does.
+func (c *Client) CreateTimeEntryContext(ctx context.Context, request harvest.CreateTimeEntryRequest) (*harvest.TimeEntry, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if err := c.check(ctx); err != nil {
+		return nil, err
+	}
+
+	entry := harvest.TimeEntry{
+		ID:          c.nextID,
+		SpentDate:   request.SpentDate,
+		Hours:       request.Hours,
+		StartedTime: request.StartedTime,
+		EndedTime:   request.EndedTime,
+		Notes:       request.Notes,
+		UpdatedAt:   time.Now(),
+	}
+	if entry.Hours == 0 {
+		entry.Hours = clockHours(entry.StartedTime, entry.EndedTime)
+	}
+	assignment, err := c.assign(&entry, request.ProjectID, request.TaskID, "failed to create time entry")
+	if err != nil {
+		return nil, err
+	}
+	// Like Harvest, default billable from the task assignment
+	entry.IsBillable = assignment.Billable
+	if request.IsBillable != nil {
+		entry.IsBillable = *request.IsBillable
+	}
+
+	if entry.Hours == 0 && entry.EndedTime == "" {
+		c.stopRunning()
+		entry.IsRunning = true
+	}
+
+	c.nextID++
+	c.entries = append(c.entries, entry)
+	return &entry, nil
+}
+
+// UpdateTimeEntryContext applies the non-nil fields of request to an entry.
+func (c *Client) UpdateTimeEntryContext(ctx context.Context, id int, request harvest.UpdateTimeEntryRequest) (*harvest.TimeEntry, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if err := c.check(ctx); err != nil {
+		return nil, err
+	}
+	entry, err := c.editable(id, "failed to update time entry")
+	if err != nil {
+		return nil, err
+	}
+
+	updated := *entry
+	if request.ProjectID != nil || request.TaskID != nil {
+		projectID, taskID := updated.Project.ID, updated.Task.ID
+		if request.ProjectID != nil {
+			projectID = *request.ProjectID
+		}
+		if request.TaskID != nil {
+			taskID = *request.TaskID
+		}
+		if _, err := c.assign(&updated, projectID, taskID, "failed to update time entry"); err != nil {
+			return nil, err
+		}
+	}
+	if request.SpentDate != nil {
+		updated.SpentDate = *request.SpentDate
+	}
+	if request.StartedTime != nil {
+		updated.StartedTime = *request.StartedTime
+	}
+	if request.EndedTime != nil {
+		updated.EndedTime = *request.EndedTime
+	}
+	if request.Hours != nil {
+		updated.Hours = *request.Hours
+	} else if request.StartedTime != nil || request.EndedTime != nil {
+		if hours := clockHours(updated.StartedTime, updated.EndedTime); hours > 0 {
+			updated.Hours = hours
+		}
+	}
+	if request.Notes != nil {
+		updated.Notes = *request.Notes
+	}
+	if request.IsBillable != nil {
+		updated.IsBillable = *request.IsBillable
+	}
+	updated.UpdatedAt = time.Now()
+
+	*entry = updated
+	return &updated, nil
+}
+
+// DeleteTimeEntryContext removes an entry.
+func (c *Client) DeleteTimeEntryContext(ctx context.Context, id int) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if err := c.check(ctx); err != nil {
+		return err
+	}
+	if _, err := c.editable(id, "failed to delete time entry"); err != nil {
+		return err
+	}
+
+	for i := range c.entries {
+		if c.entries[i].ID == id {
+			c.entries = append(c.entries[:i], c.entries[i+1:]...)
+			break
+		}
+	}
+	return nil
+}
+
+// RestartTimeEntryContext starts the timer on an entry, stopping any other
+// running timer first as Harvest does.
+func (c *Client) RestartTimeEntryContext(ctx context.Context, id int) (*harvest.TimeEntry, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if err := c.check(ctx); err != nil {
+		return nil, err
+	}
+	entry, err := c.editable(id, "failed to restart time entry")
+	if err != nil {
+		return nil, err
+	}
+
+	c.stopRunning()
+	entry.IsRunning = true
+	entry.UpdatedAt = time.Now()
+	restarted := *entry
+	return &restarted, nil
+}
+
+// StopTimeEntryContext stops the timer on an entry.
+func (c *Client) StopTimeEntryContext(ctx context.Context, id int) (*harvest.TimeEntry, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if err := c.check(ctx); err != nil {
+		return nil, err
+	}
+	entry, err := c.editable(id, "failed to stop time entry")
+	if err != nil {
+		return nil, err
+	}
+
+	entry.IsRunning = false
+	entry.UpdatedAt = time.Now()
+	stopped := *entry
+	return &stopped, nil
+}
+
+// stopRunning stops any running timer, marking it updated. Callers must hold c.mu.
+func (c *Client) stopRunning() {
+	for i := range c.entries {
+		if c.entries[i].IsRunning {
+			c.entries[i].IsRunning = false
+			c.entries[i].UpdatedAt = time.Now()
+		}
+	}
+}
+
+// clockHours returns the hours between two Harvest times such as "8:00am" or
+// "14:30", or zero when either is missing or unparsable.
+func clockHours(started, ended string) float64 {
+	start, ok := parseClock(started)
+	if !ok {
+		return 0
+	}
+	end, ok := parseClock(ended)
+	if !ok || end.Before(start) {
+		return 0
+	}
+	return end.Sub(start).Hours()
+}
+
+// parseClock parses a time of day in Harvest's 12-hour or 24-hour format.
+func parseClock(value string) (time.Time, bool) {
+	for _, layout := range []string{"3:04pm", "15:04"} {
+		if t, err := time.Parse(layout, value); err == nil {
+			return t, true
+		}
+	}
+	return time.Time{}, false
+}
+
+// check returns the error a call should fail with before doing any work.
+// Callers must hold c.mu.
+func (c *Client) check(ctx context.Context) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	return c.err
+}
+
+// editable returns the stored entry with id, or the APIError Harvest returns
+// for a missing or locked entry. Callers must hold c.mu.
+func (c *Client) editable(id int, summary string) (*harvest.TimeEntry, error) {
+	for i := range c.entries {
+		if c.entries[i].ID != id {
+			continue
+		}
+		if c.entries[i].IsLocked {
+			return nil, harvest.NewAPIError(summary, http.StatusUnprocessableEntity, "This time entry is locked and can't be edited")
+		}
+		return &c.entries[i], nil
+	}
+	return nil, harvest.NewAPIError(summary, http.StatusNotFound, "Not found")
+}
+
+// assign points entry at the given project and task, filling in the client,
+// project and task names, and returns the matching task assignment. Callers
+// must hold c.mu.
+func (c *Client) assign(entry *harvest.TimeEntry, projectID, taskID int, summary string) (harvest.TaskAssignment, error) {
+	for _, project := range c.projects {
+		if project.ID != projectID {
+			continue
+		}
+		for _, ta := range c.taskAssignments {
+			if ta.Project.ID == projectID && ta.Task.ID == taskID {
+				entry.Client = harvest.TimeEntryClient{ID: project.Client.ID, Name: project.Client.Name}
+				entry.Project = harvest.TimeEntryProject{ID: project.ID, Name: project.Name}
+				entry.Task = harvest.TimeEntryTask{ID: ta.Task.ID, Name: ta.Task.Name}
+				return ta, nil
+			}
+		}
+	}
+	return harvest.TaskAssignment{}, harvest.NewAPIError(summary, http.StatusUnprocessableEntity, "Task is not assigned to the project")
+}
@@ -0,0 +1,247 @@
+package harvestfake
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/planetargon/harvest-tui/internal/harvest"
+)
+
+func newSeededClient() *Client {
+	c := New()
+	c.AddProject(
+		harvest.Project{ID: 10, Name: "Website", Client: harvest.ProjectClient{ID: 1, Name: "Acme"}},
+		harvest.Task{ID: 100, Name: "Development", Billable: true},
+		harvest.Task{ID: 101, Name: "Design", Billable: true},
+	)
+	return c
+}
+
+func TestClient(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("given assigned project and task when entry created then names are filled in", func(t *testing.T) {
+		c := newSeededClient()
+
+		entry, err := c.CreateTimeEntryContext(ctx, harvest.CreateTimeEntryRequest{ProjectID: 10, TaskID: 100, SpentDate: "2025-01-15", Hours: 1.5, Notes: "Build"})
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+
+		if entry.ID == 0 {
+			t.Error("expected an ID to be assigned")
+		}
+		if entry.Client.Name != "Acme" || entry.Project.Name != "Website" || entry.Task.Name != "Development" {
+			t.Errorf("expected names to be filled in, got %+v", entry)
+		}
+		if !entry.IsBillable {
+			t.Error("expected entry to be billable by default")
+		}
+	})
+
+	t.Run("given unassigned task when entry created then returns validation error", func(t *testing.T) {
+		c := newSeededClient()
+
+		_, err := c.CreateTimeEntryContext(ctx, harvest.CreateTimeEntryRequest{ProjectID: 10, TaskID: 999, SpentDate: "2025-01-15"})
+
+		var apiErr *harvest.APIError
+		if !errors.As(err, &apiErr) || !apiErr.IsValidation() {
+			t.Errorf("expected validation APIError, got %v", err)
+		}
+	})
+
+	t.Run("given added project when project assignments fetched then its tasks are embedded", func(t *testing.T) {
+		c := newSeededClient()
+
+		assignments, err := c.FetchProjectAssignmentsContext(ctx)
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+
+		if len(assignments) != 1 {
+			t.Fatalf("expected one project assignment, got %d", len(assignments))
+		}
+		if assignments[0].Project.ID != 10 || assignments[0].Client.Name != "Acme" {
+			t.Errorf("expected Acme Website, got %+v", assignments[0])
+		}
+		if len(assignments[0].TaskAssignments) != 2 {
+			t.Errorf("expected two task assignments, got %d", len(assignments[0].TaskAssignments))
+		}
+	})
+
+	t.Run("given entries across dates when range fetched then returns matching entries newest first", func(t *testing.T) {
+		c := newSeededClient()
+		c.AddTimeEntry(harvest.TimeEntry{SpentDate: "2025-01-13"})
+		c.AddTimeEntry(harvest.TimeEntry{SpentDate: "2025-01-15"})
+		c.AddTimeEntry(harvest.TimeEntry{SpentDate: "2025-01-20"})
+
+		entries, err := c.FetchTimeEntriesRangeContext(ctx, "2025-01-13", "2025-01-19")
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+
+		if len(entries) != 2 || entries[0].SpentDate != "2025-01-15" || entries[1].SpentDate != "2025-01-13" {
+			t.Errorf("expected two entries newest first, got %+v", entries)
+		}
+	})
+
+	t.Run("given entries changed after a time when changes fetched then returns only those", func(t *testing.T) {
+		c := newSeededClient()
+		old := c.AddTimeEntry(harvest.TimeEntry{SpentDate: "2025-01-15", Hours: 1, UpdatedAt: time.Now().Add(-time.Hour)})
+		c.AddTimeEntry(harvest.TimeEntry{SpentDate: "2025-01-15", Hours: 2, UpdatedAt: time.Now().Add(-time.Hour)})
+		since := time.Now()
+
+		notes := "Edited"
+		if _, err := c.UpdateTimeEntryContext(ctx, old.ID, harvest.UpdateTimeEntryRequest{Notes: &notes}); err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+
+		changes, err := c.FetchTimeEntryChangesContext(ctx, "2025-01-15", "2025-01-15", since)
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		if len(changes.Updated) != 1 || changes.Updated[0].Notes != "Edited" {
+			t.Errorf("expected only the edited entry, got %+v", changes.Updated)
+		}
+	})
+
+	t.Run("given entry when updated then only provided fields change", func(t *testing.T) {
+		c := newSeededClient()
+		created, _ := c.CreateTimeEntryContext(ctx, harvest.CreateTimeEntryRequest{ProjectID: 10, TaskID: 100, SpentDate: "2025-01-15", Hours: 1, Notes: "Before"})
+		taskID := 101
+		notes := "After"
+
+		updated, err := c.UpdateTimeEntryContext(ctx, created.ID, harvest.UpdateTimeEntryRequest{TaskID: &taskID, Notes: &notes})
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+
+		if updated.Notes != "After" || updated.Task.Name != "Design" || updated.Hours != 1 {
+			t.Errorf("expected notes and task to change only, got %+v", updated)
+		}
+		if stored := c.TimeEntries()[0]; stored.Notes != "After" {
+			t.Errorf("expected update to be stored, got %+v", stored)
+		}
+	})
+
+	t.Run("given start and end times without hours when entry created then hours span the times", func(t *testing.T) {
+		c := newSeededClient()
+
+		entry, err := c.CreateTimeEntryContext(ctx, harvest.CreateTimeEntryRequest{ProjectID: 10, TaskID: 100, SpentDate: "2025-01-15", StartedTime: "9:15am", EndedTime: "10:45am"})
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+
+		if entry.Hours != 1.5 || entry.IsRunning {
+			t.Errorf("expected a stopped 1.5 hour entry, got %+v", entry)
+		}
+
+		ended := "13:00"
+		updated, err := c.UpdateTimeEntryContext(ctx, entry.ID, harvest.UpdateTimeEntryRequest{EndedTime: &ended})
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		if updated.Hours != 3.75 || updated.StartedTime != "9:15am" {
+			t.Errorf("expected hours recomputed to 3.75, got %+v", updated)
+		}
+	})
+
+	t.Run("given running timer when another entry restarted then the first is stopped", func(t *testing.T) {
+		c := newSeededClient()
+		first := c.AddTimeEntry(harvest.TimeEntry{SpentDate: "2025-01-15", IsRunning: true})
+		second := c.AddTimeEntry(harvest.TimeEntry{SpentDate: "2025-01-15"})
+
+		restarted, err := c.RestartTimeEntryContext(ctx, second.ID)
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+
+		if !restarted.IsRunning {
+			t.Error("expected restarted entry to be running")
+		}
+		for _, entry := range c.TimeEntries() {
+			if entry.ID == first.ID && entry.IsRunning {
+				t.Error("expected previously running entry to be stopped")
+			}
+		}
+	})
+
+	t.Run("given locked entry when deleted then returns validation error and keeps it", func(t *testing.T) {
+		c := newSeededClient()
+		locked := c.AddTimeEntry(harvest.TimeEntry{SpentDate: "2025-01-15", IsLocked: true})
+
+		err := c.DeleteTimeEntryContext(ctx, locked.ID)
+
+		var apiErr *harvest.APIError
+		if !errors.As(err, &apiErr) || !apiErr.IsValidation() {
+			t.Errorf("expected validation APIError, got %v", err)
+		}
+		if len(c.TimeEntries()) != 1 {
+			t.Error("expected locked entry to be kept")
+		}
+	})
+
+	t.Run("given missing entry when stopped then returns not found error", func(t *testing.T) {
+		c := newSeededClient()
+
+		_, err := c.StopTimeEntryContext(ctx, 42)
+
+		var apiErr *harvest.APIError
+		if !errors.As(err, &apiErr) || !apiErr.IsNotFound() {
+			t.Errorf("expected not found APIError, got %v", err)
+		}
+	})
+
+	t.Run("given injected error when any call made then returns it until cleared", func(t *testing.T) {
+		c := newSeededClient()
+		injected := errors.New("offline")
+		c.SetError(injected)
+
+		if _, err := c.FetchProjectsContext(ctx); !errors.Is(err, injected) {
+			t.Errorf("expected injected error, got %v", err)
+		}
+
+		c.SetError(nil)
+		if _, err := c.FetchProjectsContext(ctx); err != nil {
+			t.Errorf("expected no error after clearing, got %v", err)
+		}
+	})
+
+	t.Run("given cancelled context when called then returns context error", func(t *testing.T) {
+		c := newSeededClient()
+		cancelled, cancel := context.WithCancel(ctx)
+		cancel()
+
+		if _, err := c.FetchTimeEntriesContext(cancelled, "2025-01-15"); !errors.Is(err, context.Canceled) {
+			t.Errorf("expected context.Canceled, got %v", err)
+		}
+	})
+
+	t.Run("given demo client when created then has today's entries and a single running timer", func(t *testing.T) {
+		today := time.Date(2025, 1, 15, 0, 0, 0, 0, time.UTC)
+		c := NewDemo(today)
+
+		entries, err := c.FetchTimeEntriesContext(ctx, "2025-01-15")
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		if len(entries) == 0 {
+			t.Fatal("expected entries for today")
+		}
+
+		running := 0
+		for _, entry := range c.TimeEntries() {
+			if entry.IsRunning {
+				running++
+			}
+			if entry.Project.Name == "" || entry.Task.Name == "" {
+				t.Errorf("expected demo entries to reference projects and tasks, got %+v", entry)
+			}
+		}
+		if running != 1 {
+			t.Errorf("expected exactly one running timer, got %d", running)
+		}
+	})
+}
@@ -0,0 +1,362 @@
+// Package harvesttest provides an in-process fake of the Harvest API v2 for
+// tests and local development. It serves the endpoints harvest.Client uses
+// over real HTTP, backed by a harvestfake.Client, so requests exercise the
+// client's headers, pagination and error handling end to end.
+package harvesttest
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/planetargon/harvest-tui/internal/harvest"
+	"github.com/planetargon/harvest-tui/internal/harvest/harvestfake"
+)
+
+// Credentials accepted by the fake server.
+const (
+	AccountID   = "fake-account"
+	AccessToken = "fake-token"
+)
+
+// DefaultPerPage is the page size used for paginated responses.
+const DefaultPerPage = 100
+
+// Server is a running fake Harvest API. Point a harvest.Client at URL with
+// the AccountID and AccessToken credentials.
+type Server struct {
+	*httptest.Server
+
+	// Fake holds the server's data; seed or inspect it directly.
+	Fake *harvestfake.Client
+
+	mu      sync.Mutex
+	user    harvest.User
+	company harvest.Company
+	perPage int
+}
+
+// NewServer starts a fake server backed by fake, or by an empty store when
+// fake is nil. Callers must Close it when done.
+func NewServer(fake *harvestfake.Client) *Server {
+	if fake == nil {
+		fake = harvestfake.New()
+	}
+	s := &Server{
+		Fake: fake,
+		user: harvest.User{ID: 1, FirstName: "Demo", LastName: "User", Email: "demo@example.com"},
+		company: harvest.Company{
+			Name:         "Demo Company",
+			TimeFormat:   "hours_minutes",
+			Clock:        "12h",
+			DateFormat:   "%m/%d/%Y",
+			WeekStartDay: "Monday",
+		},
+		perPage: DefaultPerPage,
+	}
+	s.Server = httptest.NewServer(s.Handler())
+	return s
+}
+
+// SetUser sets the user returned by /v2/users/me.
+func (s *Server) SetUser(user harvest.User) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.user = user
+}
+
+// SetCompany sets the settings returned by /v2/company.
+func (s *Server) SetCompany(company harvest.Company) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.company = company
+}
+
+// SetPerPage sets the page size for paginated responses.
+func (s *Server) SetPerPage(perPage int) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.perPage = perPage
+}
+
+// Handler returns the HTTP handler serving the fake API.
+func (s *Server) Handler() http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /v2/users/me", s.handleMe)
+	mux.HandleFunc("GET /v2/users/me/project_assignments", s.handleProjectAssignments)
+	mux.HandleFunc("GET /v2/company", s.handleCompany)
+	mux.HandleFunc("GET /v2/projects", s.handleProjects)
+	mux.HandleFunc("GET /v2/task_assignments", s.handleTaskAssignments)
+	mux.HandleFunc("GET /v2/time_entries", s.handleListTimeEntries)
+	mux.HandleFunc("POST /v2/time_entries", s.handleCreateTimeEntry)
+	mux.HandleFunc("PATCH /v2/time_entries/{id}", s.handleUpdateTimeEntry)
+	mux.HandleFunc("DELETE /v2/time_entries/{id}", s.handleDeleteTimeEntry)
+	mux.HandleFunc("PATCH /v2/time_entries/{id}/restart", s.handleRestartTimeEntry)
+	mux.HandleFunc("PATCH /v2/time_entries/{id}/stop", s.handleStopTimeEntry)
+	return authenticate(mux)
+}
+
+// authenticate rejects requests without the fake credentials, as Harvest
+// does for a bad token or account ID.
+func authenticate(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer "+AccessToken || r.Header.Get("Harvest-Account-Id") != AccountID {
+			writeJSON(w, http.StatusUnauthorized, map[string]string{
+				"error":             "invalid_token",
+				"error_description": "The access token provided is expired, revoked, malformed or invalid for other reasons.",
+			})
+			return
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+func (s *Server) handleMe(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	user := s.user
+	s.mu.Unlock()
+	writeJSON(w, http.StatusOK, user)
+}
+
+func (s *Server) handleCompany(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	company := s.company
+	s.mu.Unlock()
+	writeJSON(w, http.StatusOK, company)
+}
+
+func (s *Server) handleProjects(w http.ResponseWriter, r *http.Request) {
+	projects, err := s.Fake.FetchProjectsContext(r.Context())
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	writePage(s, w, r, "projects", projects)
+}
+
+func (s *Server) handleTaskAssignments(w http.ResponseWriter, r *http.Request) {
+	assignments, err := s.Fake.FetchTaskAssignmentsContext(r.Context())
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	writePage(s, w, r, "task_assignments", assignments)
+}
+
+func (s *Server) handleProjectAssignments(w http.ResponseWriter, r *http.Request) {
+	assignments, err := s.Fake.FetchProjectAssignmentsContext(r.Context())
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	writePage(s, w, r, "project_assignments", assignments)
+}
+
+// handleListTimeEntries supports the from, to, user_id, project_id,
+// client_id, task_id, is_running and updated_since filters.
+func (s *Server) handleListTimeEntries(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+	var since time.Time
+	if value := query.Get("updated_since"); value != "" {
+		parsed, err := time.Parse(time.RFC3339, value)
+		if err != nil {
+			writeJSON(w, http.StatusUnprocessableEntity, map[string]string{"message": "Invalid updated_since"})
+			return
+		}
+		since = parsed
+	}
+	changes, err := s.Fake.FetchTimeEntryChangesContext(r.Context(), query.Get("from"), query.Get("to"), since)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	entries := changes.Updated
+
+	s.mu.Lock()
+	userID := s.user.ID
+	s.mu.Unlock()
+	if id := query.Get("user_id"); id != "" && id != strconv.Itoa(userID) {
+		entries = nil
+	}
+
+	filtered := []harvest.TimeEntry{}
+	for _, entry := range entries {
+		if matchesID(query.Get("project_id"), entry.Project.ID) &&
+			matchesID(query.Get("client_id"), entry.Client.ID) &&
+			matchesID(query.Get("task_id"), entry.Task.ID) &&
+			matchesBool(query.Get("is_running"), entry.IsRunning) {
+			filtered = append(filtered, entry)
+		}
+	}
+	writePage(s, w, r, "time_entries", filtered)
+}
+
+func (s *Server) handleCreateTimeEntry(w http.ResponseWriter, r *http.Request) {
+	var request harvest.CreateTimeEntryRequest
+	if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+		writeJSON(w, http.StatusBadRequest, map[string]string{"message": "Invalid JSON"})
+		return
+	}
+	switch {
+	case request.ProjectID == 0:
+		writeJSON(w, http.StatusUnprocessableEntity, map[string]string{"message": "Project can't be blank"})
+		return
+	case request.TaskID == 0:
+		writeJSON(w, http.StatusUnprocessableEntity, map[string]string{"message": "Task can't be blank"})
+		return
+	case request.SpentDate == "":
+		writeJSON(w, http.StatusUnprocessableEntity, map[string]string{"message": "Spent date can't be blank"})
+		return
+	}
+
+	entry, err := s.Fake.CreateTimeEntryContext(r.Context(), request)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	writeJSON(w, http.StatusCreated, entry)
+}
+
+func (s *Server) handleUpdateTimeEntry(w http.ResponseWriter, r *http.Request) {
+	id, ok := entryID(w, r)
+	if !ok {
+		return
+	}
+	var request harvest.UpdateTimeEntryRequest
+	if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+		writeJSON(w, http.StatusBadRequest, map[string]string{"message": "Invalid JSON"})
+		return
+	}
+
+	entry, err := s.Fake.UpdateTimeEntryContext(r.Context(), id, request)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, entry)
+}
+
+func (s *Server) handleDeleteTimeEntry(w http.ResponseWriter, r *http.Request) {
+	id, ok := entryID(w, r)
+	if !ok {
+		return
+	}
+	if err := s.Fake.DeleteTimeEntryContext(r.Context(), id); err != nil {
+		writeError(w, err)
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+}
+
+func (s *Server) handleRestartTimeEntry(w http.ResponseWriter, r *http.Request) {
+	id, ok := entryID(w, r)
+	if !ok {
+		return
+	}
+	entry, err := s.Fake.RestartTimeEntryContext(r.Context(), id)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, entry)
+}
+
+func (s *Server) handleStopTimeEntry(w http.ResponseWriter, r *http.Request) {
+	id, ok := entryID(w, r)
+	if !ok {
+		return
+	}
+	entry, err := s.Fake.StopTimeEntryContext(r.Context(), id)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, entry)
+}
+
+// writePage writes one page of items under key with Harvest's pagination
+// fields. The page is taken from the request's page parameter.
+func writePage[T any](s *Server, w http.ResponseWriter, r *http.Request, key string, items []T) {
+	s.mu.Lock()
+	perPage := s.perPage
+	s.mu.Unlock()
+	if perPage <= 0 {
+		perPage = DefaultPerPage
+	}
+	if requested, err := strconv.Atoi(r.URL.Query().Get("per_page")); err == nil && requested > 0 {
+		perPage = min(perPage, requested)
+	}
+
+	page, err := strconv.Atoi(r.URL.Query().Get("page"))
+	if err != nil || page < 1 {
+		page = 1
+	}
+	totalPages := (len(items) + perPage - 1) / perPage
+	if totalPages == 0 {
+		totalPages = 1
+	}
+
+	start := min((page-1)*perPage, len(items))
+	end := min(start+perPage, len(items))
+
+	var nextPage, previousPage *int
+	if page < totalPages {
+		next := page + 1
+		nextPage = &next
+	}
+	if page > 1 {
+		previous := page - 1
+		previousPage = &previous
+	}
+
+	writeJSON(w, http.StatusOK, map[string]interface{}{
+		key:             append([]T{}, items[start:end]...),
+		"per_page":      perPage,
+		"total_pages":   totalPages,
+		"total_entries": len(items),
+		"page":          page,
+		"next_page":     nextPage,
+		"previous_page": previousPage,
+	})
+}
+
+// entryID parses the {id} path value, writing a 404 when it is not a number.
+func entryID(w http.ResponseWriter, r *http.Request) (int, bool) {
+	id, err := strconv.Atoi(r.PathValue("id"))
+	if err != nil {
+		writeJSON(w, http.StatusNotFound, map[string]string{"message": "Not found"})
+		return 0, false
+	}
+	return id, true
+}
+
+// matchesID reports whether id passes an optional integer query filter.
+func matchesID(param string, id int) bool {
+	return param == "" || param == strconv.Itoa(id)
+}
+
+// matchesBool reports whether value passes an optional boolean query filter.
+func matchesBool(param string, value bool) bool {
+	return param == "" || param == strconv.FormatBool(value)
+}
+
+// writeError writes err the way Harvest would, using the status and message
+// of an *harvest.APIError and a 500 otherwise.
+func writeError(w http.ResponseWriter, err error) {
+	var apiErr *harvest.APIError
+	if errors.As(err, &apiErr) {
+		writeJSON(w, apiErr.StatusCode, map[string]string{"message": apiErr.Message})
+		return
+	}
+	writeJSON(w, http.StatusInternalServerError, map[string]string{"message": err.Error()})
+}
+
+func writeJSON(w http.ResponseWriter, status int, body interface{}) {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(body)
+}
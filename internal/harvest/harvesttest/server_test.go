@@ -0,0 +1,261 @@
+package harvesttest
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/planetargon/harvest-tui/internal/harvest"
+	"github.com/planetargon/harvest-tui/internal/harvest/harvestfake"
+)
+
+func newTestServer(t *testing.T) (*Server, *harvest.Client) {
+	t.Helper()
+	fake := harvestfake.New()
+	fake.AddProject(
+		harvest.Project{ID: 10, Name: "Website", Client: harvest.ProjectClient{ID: 1, Name: "Acme"}},
+		harvest.Task{ID: 100, Name: "Development"},
+	)
+	fake.AddProject(
+		harvest.Project{ID: 20, Name: "Mobile", Client: harvest.ProjectClient{ID: 2, Name: "Globex"}},
+		harvest.Task{ID: 100, Name: "Development"},
+	)
+
+	server := NewServer(fake)
+	t.Cleanup(server.Close)
+
+	client := harvest.NewClient(AccountID, AccessToken)
+	client.SetBaseURL(server.URL)
+	return server, client
+}
+
+func TestServer(t *testing.T) {
+	t.Run("given valid credentials when auth validated then returns the fake user", func(t *testing.T) {
+		_, client := newTestServer(t)
+
+		user, err := client.ValidateAuth()
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+
+		if user.ID != 1 || user.FirstName != "Demo" {
+			t.Errorf("expected demo user, got %+v", user)
+		}
+		if client.GetUserID() != 1 {
+			t.Errorf("expected client user ID to be set, got %d", client.GetUserID())
+		}
+	})
+
+	t.Run("given wrong token when auth validated then returns unauthorized error", func(t *testing.T) {
+		server, _ := newTestServer(t)
+		client := harvest.NewClient(AccountID, "wrong")
+		client.SetBaseURL(server.URL)
+
+		_, err := client.ValidateAuth()
+
+		var apiErr *harvest.APIError
+		if !errors.As(err, &apiErr) || !apiErr.IsUnauthorized() {
+			t.Fatalf("expected unauthorized APIError, got %v", err)
+		}
+		if apiErr.Message == "" {
+			t.Error("expected error description to be surfaced")
+		}
+	})
+
+	t.Run("given custom company when company fetched then returns its settings", func(t *testing.T) {
+		server, client := newTestServer(t)
+		server.SetCompany(harvest.Company{Name: "Globex", TimeFormat: "decimal", WeekStartDay: "Sunday"})
+
+		company, err := client.FetchCompany()
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+
+		if company.Name != "Globex" || company.TimeFormat != "decimal" || company.WeekStartDay != "Sunday" {
+			t.Errorf("expected custom company, got %+v", company)
+		}
+	})
+
+	t.Run("given small page size when entries fetched then client follows next_page", func(t *testing.T) {
+		server, client := newTestServer(t)
+		server.SetPerPage(2)
+		client.ValidateAuth()
+		for i := 0; i < 5; i++ {
+			server.Fake.AddTimeEntry(harvest.TimeEntry{SpentDate: "2025-01-15", Hours: 1})
+		}
+		server.Fake.AddTimeEntry(harvest.TimeEntry{SpentDate: "2025-01-16", Hours: 1})
+
+		entries, err := client.FetchTimeEntries("2025-01-15")
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+
+		if len(entries) != 5 {
+			t.Errorf("expected 5 entries across 3 pages, got %d", len(entries))
+		}
+	})
+
+	t.Run("given small page size when projects fetched then all pages are returned", func(t *testing.T) {
+		server, client := newTestServer(t)
+		server.SetPerPage(1)
+
+		projects, err := client.FetchProjects()
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		assignments, err := client.FetchTaskAssignments()
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+
+		if len(projects) != 2 || len(assignments) != 2 {
+			t.Errorf("expected 2 projects and 2 assignments, got %d and %d", len(projects), len(assignments))
+		}
+	})
+
+	t.Run("given seeded projects when project assignments fetched then tasks are embedded", func(t *testing.T) {
+		server, client := newTestServer(t)
+		server.SetPerPage(1)
+
+		assignments, err := client.FetchProjectAssignments()
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+
+		if len(assignments) != 2 {
+			t.Fatalf("expected 2 project assignments, got %d", len(assignments))
+		}
+		if len(assignments[0].TaskAssignments) == 0 {
+			t.Error("expected task assignments embedded in the first project")
+		}
+	})
+
+	t.Run("given entry edited after a sync when the day's changes fetched then returns only it", func(t *testing.T) {
+		server, client := newTestServer(t)
+		client.ValidateAuth()
+		hourAgo := time.Now().Add(-time.Hour)
+		first := server.Fake.AddTimeEntry(harvest.TimeEntry{SpentDate: "2025-01-15", Hours: 1, UpdatedAt: hourAgo})
+		server.Fake.AddTimeEntry(harvest.TimeEntry{SpentDate: "2025-01-15", Hours: 2, UpdatedAt: hourAgo})
+
+		notes := "Edited"
+		if _, err := client.UpdateTimeEntry(first.ID, harvest.UpdateTimeEntryRequest{Notes: &notes}); err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+
+		changes, err := client.FetchTimeEntryChanges("2025-01-15", "2025-01-15", time.Now().Add(-time.Minute))
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		if len(changes.Updated) != 1 || changes.Updated[0].ID != first.ID {
+			t.Errorf("expected only the edited entry, got %+v", changes.Updated)
+		}
+	})
+
+	t.Run("given entries for several projects when filtered then returns matching entries", func(t *testing.T) {
+		server, client := newTestServer(t)
+		client.ValidateAuth()
+		client.CreateTimeEntry(harvest.CreateTimeEntryRequest{ProjectID: 10, TaskID: 100, SpentDate: "2025-01-15", Hours: 1})
+		client.CreateTimeEntry(harvest.CreateTimeEntryRequest{ProjectID: 20, TaskID: 100, SpentDate: "2025-01-15", Hours: 2})
+		running := true
+
+		entries, err := client.FetchTimeEntriesFiltered("2025-01-01", "2025-01-31", harvest.TimeEntryFilter{ClientID: 2})
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		if len(entries) != 1 || entries[0].Project.Name != "Mobile" {
+			t.Errorf("expected only the Globex entry, got %+v", entries)
+		}
+
+		entries, _ = client.FetchTimeEntriesFiltered("", "", harvest.TimeEntryFilter{IsRunning: &running})
+		if len(entries) != 0 {
+			t.Errorf("expected no running entries, got %+v", entries)
+		}
+		if len(server.Fake.TimeEntries()) != 2 {
+			t.Errorf("expected 2 stored entries, got %d", len(server.Fake.TimeEntries()))
+		}
+	})
+
+	t.Run("given entry when updated, restarted, stopped and deleted then each change is applied", func(t *testing.T) {
+		server, client := newTestServer(t)
+		created, err := client.CreateTimeEntry(harvest.CreateTimeEntryRequest{ProjectID: 10, TaskID: 100, SpentDate: "2025-01-15", Hours: 1, Notes: "Start"})
+		if err != nil {
+			t.Fatalf("expected no error creating, got %v", err)
+		}
+
+		notes := "Updated"
+		updated, err := client.UpdateTimeEntry(created.ID, harvest.UpdateTimeEntryRequest{Notes: &notes})
+		if err != nil || updated.Notes != "Updated" {
+			t.Fatalf("expected notes to be updated, got %+v, %v", updated, err)
+		}
+
+		restarted, err := client.RestartTimeEntry(created.ID)
+		if err != nil || !restarted.IsRunning {
+			t.Fatalf("expected entry to be running, got %+v, %v", restarted, err)
+		}
+
+		stopped, err := client.StopTimeEntry(created.ID)
+		if err != nil || stopped.IsRunning {
+			t.Fatalf("expected entry to be stopped, got %+v, %v", stopped, err)
+		}
+
+		if err := client.DeleteTimeEntry(created.ID); err != nil {
+			t.Fatalf("expected no error deleting, got %v", err)
+		}
+		if len(server.Fake.TimeEntries()) != 0 {
+			t.Error("expected entry to be deleted")
+		}
+	})
+
+	t.Run("given running timer when another entry restarted then only one timer runs", func(t *testing.T) {
+		server, client := newTestServer(t)
+		first := server.Fake.AddTimeEntry(harvest.TimeEntry{SpentDate: "2025-01-15", IsRunning: true})
+		second := server.Fake.AddTimeEntry(harvest.TimeEntry{SpentDate: "2025-01-15"})
+
+		if _, err := client.RestartTimeEntry(second.ID); err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+
+		for _, entry := range server.Fake.TimeEntries() {
+			if entry.IsRunning != (entry.ID == second.ID) {
+				t.Errorf("expected only entry %d running, first was %d: %+v", second.ID, first.ID, entry)
+			}
+		}
+	})
+
+	t.Run("given locked entry when deleted then returns Harvest's locked message", func(t *testing.T) {
+		server, client := newTestServer(t)
+		locked := server.Fake.AddTimeEntry(harvest.TimeEntry{SpentDate: "2025-01-15", IsLocked: true})
+
+		err := client.DeleteTimeEntry(locked.ID)
+
+		var apiErr *harvest.APIError
+		if !errors.As(err, &apiErr) || !apiErr.IsValidation() {
+			t.Fatalf("expected validation APIError, got %v", err)
+		}
+		if apiErr.Message != "This time entry is locked and can't be edited" {
+			t.Errorf("expected locked message, got %q", apiErr.Message)
+		}
+	})
+
+	t.Run("given missing fields when entry created then returns validation error", func(t *testing.T) {
+		_, client := newTestServer(t)
+
+		_, err := client.CreateTimeEntry(harvest.CreateTimeEntryRequest{ProjectID: 10, SpentDate: "2025-01-15"})
+
+		var apiErr *harvest.APIError
+		if !errors.As(err, &apiErr) || apiErr.Message != "Task can't be blank" {
+			t.Errorf("expected task validation error, got %v", err)
+		}
+	})
+
+	t.Run("given unknown entry when stopped then returns not found", func(t *testing.T) {
+		_, client := newTestServer(t)
+
+		_, err := client.StopTimeEntry(404)
+
+		var apiErr *harvest.APIError
+		if !errors.As(err, &apiErr) || !apiErr.IsNotFound() {
+			t.Errorf("expected not found APIError, got %v", err)
+		}
+	})
+}
@@ -0,0 +1,66 @@
+package harvest
+
+import (
+	"fmt"
+	"strings"
+)
+
+// FindProjectTask resolves a "project/task" or "client/project/task" query
+// against projects. Names are matched ignoring case, and a unique part of a
+// name is enough.
+func FindProjectTask(projects []ProjectWithTasks, query string) (Project, Task, error) {
+	slash := strings.LastIndex(query, "/")
+	if slash <= 0 || slash == len(query)-1 {
+		return Project{}, Task{}, fmt.Errorf("expected project/task, got %q", query)
+	}
+
+	project, err := matchOne("project", query[:slash], projects, func(p ProjectWithTasks) []string {
+		return []string{p.Project.Name, p.Project.Client.Name + "/" + p.Project.Name}
+	})
+	if err != nil {
+		return Project{}, Task{}, err
+	}
+	task, err := matchOne("task", query[slash+1:], project.Tasks, func(t Task) []string {
+		return []string{t.Name}
+	})
+	if err != nil {
+		return Project{}, Task{}, err
+	}
+	return project.Project, task, nil
+}
+
+// matchOne finds the item one of whose names equals query, ignoring case, or
+// failing that the only item with a name containing it.
+func matchOne[T any](kind, query string, items []T, names func(T) []string) (T, error) {
+	var zero T
+	query = strings.ToLower(strings.TrimSpace(query))
+
+	var exact, partial []T
+	var partialNames []string
+	for _, item := range items {
+		itemNames := names(item)
+		for _, name := range itemNames {
+			if strings.ToLower(name) == query {
+				exact = append(exact, item)
+				break
+			}
+		}
+		for _, name := range itemNames {
+			if strings.Contains(strings.ToLower(name), query) {
+				partial = append(partial, item)
+				partialNames = append(partialNames, itemNames[len(itemNames)-1])
+				break
+			}
+		}
+	}
+
+	switch {
+	case len(exact) == 1:
+		return exact[0], nil
+	case len(exact) == 0 && len(partial) == 1:
+		return partial[0], nil
+	case len(partial) == 0:
+		return zero, fmt.Errorf("no %s matches %q", kind, query)
+	}
+	return zero, fmt.Errorf("%s %q is ambiguous: %s", kind, query, strings.Join(partialNames, ", "))
+}
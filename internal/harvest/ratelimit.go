@@ -0,0 +1,124 @@
+package harvest
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// Harvest allows 100 requests per 15 seconds for each access token.
+// See https://help.getharvest.com/api-v2/introduction/overview/general/#rate-limiting
+const (
+	DefaultRateLimitRequests = 100
+	DefaultRateLimitWindow   = 15 * time.Second
+)
+
+// rateLimiter is a token bucket shared by every request a Client makes. The
+// bucket starts full so short bursts go out immediately; once it is empty,
+// callers wait in arrival order for tokens to refill.
+type rateLimiter struct {
+	mu         sync.Mutex
+	capacity   float64
+	tokens     float64
+	perSecond  float64
+	last       time.Time
+	waiting    int
+	now        func() time.Time
+	onThrottle func(throttled bool)
+
+	// notifyMu orders throttle notifications, and notified is the state last
+	// sent, so a late one never contradicts the current state
+	notifyMu sync.Mutex
+	notified bool
+}
+
+// newRateLimiter returns a limiter allowing requests per window.
+func newRateLimiter(requests int, window time.Duration) *rateLimiter {
+	return &rateLimiter{
+		capacity:  float64(requests),
+		tokens:    float64(requests),
+		perSecond: float64(requests) / window.Seconds(),
+		now:       time.Now,
+	}
+}
+
+// wait blocks until a request may be sent or ctx is done. The throttle
+// handler is notified when the first caller starts waiting and again when
+// the last waiting caller is released. A nil limiter never waits.
+func (l *rateLimiter) wait(ctx context.Context, sleep func(context.Context, time.Duration) error) error {
+	if l == nil {
+		return nil
+	}
+	l.mu.Lock()
+	l.refill()
+	l.tokens--
+	if l.tokens >= 0 {
+		l.mu.Unlock()
+		return nil
+	}
+
+	// Reserve a token ahead of time so waiting callers are served in order
+	delay := time.Duration(-l.tokens / l.perSecond * float64(time.Second))
+	l.waiting++
+	first := l.waiting == 1
+	l.mu.Unlock()
+	if first {
+		l.notifyThrottle()
+	}
+
+	err := sleep(ctx, delay)
+
+	l.mu.Lock()
+	if err != nil {
+		// Hand the unused reservation back to callers still waiting
+		l.tokens++
+	}
+	l.waiting--
+	last := l.waiting == 0
+	l.mu.Unlock()
+	if last {
+		l.notifyThrottle()
+	}
+	return err
+}
+
+// notifyThrottle sends the throttle handler whether callers are waiting now,
+// unless that was the last state sent. It runs outside l.mu, so a handler
+// that blocks holds up only callers starting or ending a wait.
+func (l *rateLimiter) notifyThrottle() {
+	l.notifyMu.Lock()
+	defer l.notifyMu.Unlock()
+
+	l.mu.Lock()
+	throttled := l.waiting > 0
+	handler := l.onThrottle
+	l.mu.Unlock()
+
+	if handler == nil || throttled == l.notified {
+		return
+	}
+	l.notified = throttled
+	handler(throttled)
+}
+
+// refill adds the tokens earned since the last call. Callers must hold l.mu.
+func (l *rateLimiter) refill() {
+	now := l.now()
+	if !l.last.IsZero() {
+		l.tokens += now.Sub(l.last).Seconds() * l.perSecond
+		if l.tokens > l.capacity {
+			l.tokens = l.capacity
+		}
+	}
+	l.last = now
+}
+
+// throttled reports whether any request is currently waiting for a token.
+func (l *rateLimiter) throttled() bool {
+	if l == nil {
+		return false
+	}
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.waiting > 0
+}
@@ -0,0 +1,238 @@
+package harvest
+
+import (
+	"context"
+	"errors"
+	"math/rand"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// fakeClock is a controllable time source whose sleep advances the clock.
+type fakeClock struct {
+	now    time.Time
+	sleeps []time.Duration
+}
+
+func (c *fakeClock) Now() time.Time { return c.now }
+
+func (c *fakeClock) Sleep(ctx context.Context, d time.Duration) error {
+	c.sleeps = append(c.sleeps, d)
+	c.now = c.now.Add(d)
+	return ctx.Err()
+}
+
+func newTestLimiter(requests int, window time.Duration) (*rateLimiter, *fakeClock) {
+	clock := &fakeClock{now: time.Date(2025, 1, 15, 9, 0, 0, 0, time.UTC)}
+	limiter := newRateLimiter(requests, window)
+	limiter.now = clock.Now
+	return limiter, clock
+}
+
+func TestRateLimiter(t *testing.T) {
+	t.Run("given full bucket when burst within capacity then no request waits", func(t *testing.T) {
+		limiter, clock := newTestLimiter(100, 15*time.Second)
+
+		for i := 0; i < 100; i++ {
+			if err := limiter.wait(context.Background(), clock.Sleep); err != nil {
+				t.Fatalf("expected no error, got %v", err)
+			}
+		}
+
+		if len(clock.sleeps) != 0 {
+			t.Errorf("expected no waits, got %v", clock.sleeps)
+		}
+	})
+
+	t.Run("given empty bucket when request made then waits for one token to refill", func(t *testing.T) {
+		limiter, clock := newTestLimiter(100, 15*time.Second)
+		for i := 0; i < 100; i++ {
+			limiter.wait(context.Background(), clock.Sleep)
+		}
+
+		if err := limiter.wait(context.Background(), clock.Sleep); err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+
+		if len(clock.sleeps) != 1 || clock.sleeps[0] != 150*time.Millisecond {
+			t.Errorf("expected a single 150ms wait, got %v", clock.sleeps)
+		}
+	})
+
+	t.Run("given sustained load when many requests made then never exceeds the window limit", func(t *testing.T) {
+		limiter, clock := newTestLimiter(10, 15*time.Second)
+		start := clock.now
+
+		for i := 0; i < 30; i++ {
+			limiter.wait(context.Background(), clock.Sleep)
+		}
+
+		// 10 burst immediately, the remaining 20 refill at 1.5s each
+		if elapsed := clock.now.Sub(start); elapsed < 30*time.Second-time.Millisecond {
+			t.Errorf("expected at least 30s for 30 requests at 10 per 15s, got %v", elapsed)
+		}
+	})
+
+	t.Run("given idle time when bucket refills then caps at capacity", func(t *testing.T) {
+		limiter, clock := newTestLimiter(10, 15*time.Second)
+		limiter.wait(context.Background(), clock.Sleep)
+		clock.now = clock.now.Add(time.Hour)
+
+		for i := 0; i < 11; i++ {
+			limiter.wait(context.Background(), clock.Sleep)
+		}
+
+		if len(clock.sleeps) != 1 {
+			t.Errorf("expected only the request beyond capacity to wait, got %v", clock.sleeps)
+		}
+	})
+
+	t.Run("given throttle handler when a request waits then notifies start and end", func(t *testing.T) {
+		limiter, clock := newTestLimiter(1, time.Second)
+		var events []bool
+		limiter.onThrottle = func(throttled bool) { events = append(events, throttled) }
+
+		limiter.wait(context.Background(), clock.Sleep)
+		limiter.wait(context.Background(), func(ctx context.Context, d time.Duration) error {
+			if !limiter.throttled() {
+				t.Error("expected limiter to report throttled while waiting")
+			}
+			return clock.Sleep(ctx, d)
+		})
+
+		if len(events) != 2 || events[0] != true || events[1] != false {
+			t.Errorf("expected [true false], got %v", events)
+		}
+		if limiter.throttled() {
+			t.Error("expected limiter not to be throttled after wait")
+		}
+	})
+
+	t.Run("given throttle handler that checks the limiter when a request waits then does not deadlock", func(t *testing.T) {
+		limiter, clock := newTestLimiter(1, time.Second)
+		var events []bool
+		limiter.onThrottle = func(bool) { events = append(events, limiter.throttled()) }
+		limiter.wait(context.Background(), clock.Sleep)
+
+		done := make(chan struct{})
+		go func() {
+			limiter.wait(context.Background(), clock.Sleep)
+			close(done)
+		}()
+
+		select {
+		case <-done:
+		case <-time.After(time.Second):
+			t.Fatal("expected the handler to run without the limiter's lock")
+		}
+		if len(events) != 2 || events[0] != true || events[1] != false {
+			t.Errorf("expected [true false], got %v", events)
+		}
+	})
+
+	t.Run("given callers waiting one after another when each is released then notifications alternate and end unthrottled", func(t *testing.T) {
+		limiter := newRateLimiter(1, 10*time.Millisecond)
+		var mu sync.Mutex
+		var events []bool
+		limiter.onThrottle = func(throttled bool) {
+			// A slow handler, like a busy UI, lets the next wait start first
+			if !throttled {
+				time.Sleep(3 * time.Millisecond)
+			}
+			mu.Lock()
+			events = append(events, throttled)
+			mu.Unlock()
+		}
+
+		var wg sync.WaitGroup
+		for i := 0; i < 20; i++ {
+			wg.Add(1)
+			go func(i int) {
+				defer wg.Done()
+				time.Sleep(time.Duration(i) * 2 * time.Millisecond)
+				limiter.wait(context.Background(), func(ctx context.Context, d time.Duration) error {
+					time.Sleep(time.Duration(rand.Intn(1000)) * time.Microsecond)
+					return nil
+				})
+			}(i)
+		}
+		wg.Wait()
+
+		if limiter.throttled() {
+			t.Error("expected no caller waiting")
+		}
+		for i, throttled := range events {
+			if throttled != (i%2 == 0) {
+				t.Fatalf("expected notifications to alternate starting with true, got %v", events)
+			}
+		}
+		if len(events) == 0 || events[len(events)-1] {
+			t.Errorf("expected the last notification to be false, got %v", events)
+		}
+	})
+
+	t.Run("given cancelled context when waiting then returns context error and releases reservation", func(t *testing.T) {
+		limiter, clock := newTestLimiter(1, time.Second)
+		limiter.wait(context.Background(), clock.Sleep)
+
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		err := limiter.wait(ctx, func(ctx context.Context, d time.Duration) error { return ctx.Err() })
+		if !errors.Is(err, context.Canceled) {
+			t.Fatalf("expected context.Canceled, got %v", err)
+		}
+
+		// The cancelled caller's token goes back to the next caller
+		clock.sleeps = nil
+		limiter.wait(context.Background(), clock.Sleep)
+		if len(clock.sleeps) != 1 || clock.sleeps[0] != time.Second {
+			t.Errorf("expected a single 1s wait, got %v", clock.sleeps)
+		}
+	})
+}
+
+func TestClientRateLimit(t *testing.T) {
+	t.Run("given exhausted rate limit when client requests then waits before sending", func(t *testing.T) {
+		var requests int32
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			atomic.AddInt32(&requests, 1)
+			w.WriteHeader(http.StatusOK)
+			w.Write([]byte(`{"projects": [], "next_page": null}`))
+		}))
+		defer server.Close()
+
+		client := NewClient("12345", "test-token")
+		client.SetBaseURL(server.URL)
+		client.SetRateLimit(2, time.Minute)
+		var waits []time.Duration
+		client.sleep = func(ctx context.Context, d time.Duration) error {
+			waits = append(waits, d)
+			return nil
+		}
+
+		for i := 0; i < 3; i++ {
+			if _, err := client.FetchProjects(); err != nil {
+				t.Fatalf("expected no error, got %v", err)
+			}
+		}
+
+		if requests != 3 {
+			t.Errorf("expected 3 requests, got %d", requests)
+		}
+		if len(waits) != 1 {
+			t.Errorf("expected the third request to wait, got %v", waits)
+		}
+	})
+
+	t.Run("given zero value client when throttle state checked then reports not throttled", func(t *testing.T) {
+		client := &Client{}
+		if client.Throttled() {
+			t.Error("expected zero value client not to be throttled")
+		}
+	})
+}
@@ -0,0 +1,106 @@
+package harvest
+
+import (
+	"context"
+	"io"
+	"math/rand"
+	"net/http"
+	"time"
+)
+
+// RetryPolicy controls how the client retries rate-limited and transient failures.
+// The zero value disables retrying.
+type RetryPolicy struct {
+	MaxRetries int           // Retries after the first attempt
+	BaseDelay  time.Duration // Backoff before the first retry, doubled on each subsequent retry
+	MaxDelay   time.Duration // Upper bound on a single wait, including Retry-After
+}
+
+// isIdempotent reports whether a request with the given method can be safely
+// repeated after a transient failure. PATCH is left out: stopping or restarting
+// a timer a second time fails once the first attempt went through.
+func isIdempotent(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodDelete:
+		return true
+	}
+	return false
+}
+
+// isRetryableStatus reports whether a response status indicates a transient failure.
+func isRetryableStatus(status int) bool {
+	switch status {
+	case http.StatusTooManyRequests, http.StatusInternalServerError, http.StatusBadGateway,
+		http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return true
+	}
+	return false
+}
+
+// shouldRetry decides whether an attempt can be retried. A 429 means Harvest
+// rejected the request without processing it, so it is safe to repeat for any
+// method. Server errors and network failures may have been applied, so they are
+// only retried for idempotent methods and never for POST or PATCH.
+func shouldRetry(method string, resp *http.Response, err error) bool {
+	if err != nil {
+		return isIdempotent(method)
+	}
+	if resp.StatusCode == http.StatusTooManyRequests {
+		return true
+	}
+	return isRetryableStatus(resp.StatusCode) && isIdempotent(method)
+}
+
+// backoff returns the jittered exponential delay before the given retry (0-based).
+// The delay is drawn uniformly from the upper half of the exponential window so
+// concurrent clients spread out without retrying immediately.
+func (p RetryPolicy) backoff(retry int) time.Duration {
+	delay := p.BaseDelay
+	for i := 0; i < retry && (p.MaxDelay == 0 || delay < p.MaxDelay); i++ {
+		delay *= 2
+	}
+	if p.MaxDelay > 0 && delay > p.MaxDelay {
+		delay = p.MaxDelay
+	}
+	if delay <= 0 {
+		return 0
+	}
+	half := delay / 2
+	return half + time.Duration(rand.Int63n(int64(half)+1))
+}
+
+// retryDelay returns how long to wait before the next attempt, honoring
+// Retry-After when Harvest sends it. The second result is false when the
+// server asks for a longer wait than the policy allows.
+func (p RetryPolicy) retryDelay(retry int, resp *http.Response) (time.Duration, bool) {
+	if resp != nil {
+		if wait := parseRetryAfter(resp.Header.Get("Retry-After"), time.Now()); wait > 0 {
+			if p.MaxDelay > 0 && wait > p.MaxDelay {
+				return 0, false
+			}
+			return wait, true
+		}
+	}
+	return p.backoff(retry), true
+}
+
+// sleepContext waits for d or until ctx is done, whichever comes first.
+func sleepContext(ctx context.Context, d time.Duration) error {
+	if d <= 0 {
+		return ctx.Err()
+	}
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+// discardBody drains and closes a response body so the connection can be reused.
+func discardBody(resp *http.Response) {
+	io.Copy(io.Discard, io.LimitReader(resp.Body, maxErrorBodySize))
+	resp.Body.Close()
+}
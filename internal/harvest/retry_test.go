@@ -0,0 +1,281 @@
+package harvest
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// newRetryTestClient returns a client pointed at url with retries enabled and
+// a recording sleep so tests run without real delays.
+func newRetryTestClient(url string, maxRetries int) (*Client, *[]time.Duration) {
+	client := NewClient("12345", "test-token")
+	client.SetBaseURL(url)
+	client.SetRetryPolicy(RetryPolicy{MaxRetries: maxRetries, BaseDelay: 100 * time.Millisecond, MaxDelay: 10 * time.Second})
+	var waits []time.Duration
+	client.sleep = func(ctx context.Context, d time.Duration) error {
+		waits = append(waits, d)
+		return ctx.Err()
+	}
+	return client, &waits
+}
+
+func TestRetry(t *testing.T) {
+	t.Run("given 429 with Retry-After then success when GET called then waits the requested time and succeeds", func(t *testing.T) {
+		var requests int32
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if atomic.AddInt32(&requests, 1) == 1 {
+				w.Header().Set("Retry-After", "3")
+				w.WriteHeader(http.StatusTooManyRequests)
+				return
+			}
+			w.WriteHeader(http.StatusOK)
+			json.NewEncoder(w).Encode(map[string]interface{}{"id": 1, "first_name": "Test"})
+		}))
+		defer server.Close()
+
+		client, waits := newRetryTestClient(server.URL, 3)
+
+		user, err := client.ValidateAuth()
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		if user.ID != 1 {
+			t.Errorf("expected user ID 1, got %d", user.ID)
+		}
+		if requests != 2 {
+			t.Errorf("expected 2 requests, got %d", requests)
+		}
+		if len(*waits) != 1 || (*waits)[0] != 3*time.Second {
+			t.Errorf("expected a single 3s wait, got %v", *waits)
+		}
+	})
+
+	t.Run("given repeated 502 when GET called then retries with growing jittered backoff and gives up", func(t *testing.T) {
+		var requests int32
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			atomic.AddInt32(&requests, 1)
+			w.WriteHeader(http.StatusBadGateway)
+		}))
+		defer server.Close()
+
+		client, waits := newRetryTestClient(server.URL, 3)
+
+		_, err := client.FetchProjects()
+
+		var apiErr *APIError
+		if !errors.As(err, &apiErr) || apiErr.StatusCode != http.StatusBadGateway {
+			t.Fatalf("expected 502 APIError, got %v", err)
+		}
+		if requests != 4 {
+			t.Errorf("expected 1 attempt plus 3 retries, got %d requests", requests)
+		}
+		if len(*waits) != 3 {
+			t.Fatalf("expected 3 waits, got %v", *waits)
+		}
+		for i, wait := range *waits {
+			window := 100 * time.Millisecond << i
+			if wait < window/2 || wait > window {
+				t.Errorf("wait %d: expected between %v and %v, got %v", i, window/2, window, wait)
+			}
+		}
+	})
+
+	t.Run("given 503 on POST when CreateTimeEntry called then does not retry", func(t *testing.T) {
+		var requests int32
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			atomic.AddInt32(&requests, 1)
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}))
+		defer server.Close()
+
+		client, waits := newRetryTestClient(server.URL, 3)
+
+		_, err := client.CreateTimeEntry(CreateTimeEntryRequest{ProjectID: 1, TaskID: 2, SpentDate: "2025-01-15", Hours: 1})
+		if err == nil {
+			t.Fatal("expected error, got nil")
+		}
+		if requests != 1 {
+			t.Errorf("expected POST not to be retried, got %d requests", requests)
+		}
+		if len(*waits) != 0 {
+			t.Errorf("expected no waits, got %v", *waits)
+		}
+	})
+
+	t.Run("given 429 on POST when CreateTimeEntry called then retries because the request was not processed", func(t *testing.T) {
+		var requests int32
+		var bodies []map[string]interface{}
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			var body map[string]interface{}
+			json.NewDecoder(r.Body).Decode(&body)
+			bodies = append(bodies, body)
+			if atomic.AddInt32(&requests, 1) == 1 {
+				w.WriteHeader(http.StatusTooManyRequests)
+				return
+			}
+			w.WriteHeader(http.StatusCreated)
+			json.NewEncoder(w).Encode(map[string]interface{}{"id": 99})
+		}))
+		defer server.Close()
+
+		client, _ := newRetryTestClient(server.URL, 3)
+
+		entry, err := client.CreateTimeEntry(CreateTimeEntryRequest{ProjectID: 1, TaskID: 2, SpentDate: "2025-01-15", Hours: 1.5, Notes: "retry me"})
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		if entry.ID != 99 {
+			t.Errorf("expected entry ID 99, got %d", entry.ID)
+		}
+		if len(bodies) != 2 || bodies[1]["notes"] != "retry me" {
+			t.Errorf("expected request body to be resent intact, got %v", bodies)
+		}
+	})
+
+	t.Run("given Retry-After beyond max delay when GET called then returns the rate limit error without waiting", func(t *testing.T) {
+		var requests int32
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			atomic.AddInt32(&requests, 1)
+			w.Header().Set("Retry-After", "600")
+			w.WriteHeader(http.StatusTooManyRequests)
+		}))
+		defer server.Close()
+
+		client, waits := newRetryTestClient(server.URL, 3)
+
+		_, err := client.FetchTaskAssignments()
+
+		var apiErr *APIError
+		if !errors.As(err, &apiErr) || !apiErr.IsRateLimited() {
+			t.Fatalf("expected rate limit APIError, got %v", err)
+		}
+		if apiErr.RetryAfter != 600*time.Second {
+			t.Errorf("expected RetryAfter to be preserved, got %v", apiErr.RetryAfter)
+		}
+		if requests != 1 || len(*waits) != 0 {
+			t.Errorf("expected a single request and no waits, got %d requests and %v", requests, *waits)
+		}
+	})
+
+	t.Run("given 422 when PATCH called then does not retry client errors", func(t *testing.T) {
+		var requests int32
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			atomic.AddInt32(&requests, 1)
+			w.WriteHeader(http.StatusUnprocessableEntity)
+		}))
+		defer server.Close()
+
+		client, _ := newRetryTestClient(server.URL, 3)
+
+		if _, err := client.StopTimeEntry(1); err == nil {
+			t.Fatal("expected error, got nil")
+		}
+		if requests != 1 {
+			t.Errorf("expected 1 request, got %d", requests)
+		}
+	})
+
+	t.Run("given no retry policy when 503 returned then fails immediately", func(t *testing.T) {
+		var requests int32
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			atomic.AddInt32(&requests, 1)
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}))
+		defer server.Close()
+
+		client := NewClient("12345", "test-token")
+		client.SetBaseURL(server.URL)
+
+		if _, err := client.FetchProjects(); err == nil {
+			t.Fatal("expected error, got nil")
+		}
+		if requests != 1 {
+			t.Errorf("expected 1 request, got %d", requests)
+		}
+	})
+
+	t.Run("given cancelled context while waiting when retrying then stops with context error", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}))
+		defer server.Close()
+
+		client := NewClient("12345", "test-token")
+		client.SetBaseURL(server.URL)
+		client.SetRetryPolicy(RetryPolicy{MaxRetries: 5, BaseDelay: time.Second, MaxDelay: time.Second})
+
+		ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+		defer cancel()
+
+		start := time.Now()
+		_, err := client.FetchProjectsContext(ctx)
+		if !errors.Is(err, context.DeadlineExceeded) {
+			t.Errorf("expected context.DeadlineExceeded, got %v", err)
+		}
+		if time.Since(start) > 900*time.Millisecond {
+			t.Errorf("expected backoff to be interrupted by the context, took %v", time.Since(start))
+		}
+	})
+}
+
+func TestShouldRetry(t *testing.T) {
+	tests := []struct {
+		name   string
+		method string
+		status int
+		err    error
+		want   bool
+	}{
+		{name: "GET 429", method: http.MethodGet, status: 429, want: true},
+		{name: "GET 500", method: http.MethodGet, status: 500, want: true},
+		{name: "GET 504", method: http.MethodGet, status: 504, want: true},
+		{name: "GET 404", method: http.MethodGet, status: 404, want: false},
+		{name: "PATCH 429", method: http.MethodPatch, status: 429, want: true},
+		{name: "PATCH 502", method: http.MethodPatch, status: 502, want: false},
+		{name: "PATCH network error", method: http.MethodPatch, err: errors.New("connection reset"), want: false},
+		{name: "DELETE 503", method: http.MethodDelete, status: 503, want: true},
+		{name: "POST 429", method: http.MethodPost, status: 429, want: true},
+		{name: "POST 502", method: http.MethodPost, status: 502, want: false},
+		{name: "GET network error", method: http.MethodGet, err: errors.New("connection reset"), want: true},
+		{name: "POST network error", method: http.MethodPost, err: errors.New("connection reset"), want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run("given "+tt.name+" when checked then returns expected decision", func(t *testing.T) {
+			var resp *http.Response
+			if tt.err == nil {
+				resp = &http.Response{StatusCode: tt.status, Header: http.Header{}}
+			}
+			if got := shouldRetry(tt.method, resp, tt.err); got != tt.want {
+				t.Errorf("expected %v, got %v", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestBackoff(t *testing.T) {
+	t.Run("given no max delay when backing off then keeps doubling the delay", func(t *testing.T) {
+		policy := RetryPolicy{MaxRetries: 5, BaseDelay: 100 * time.Millisecond, MaxDelay: 0}
+
+		for retry, want := range []time.Duration{100, 200, 400, 800} {
+			want *= time.Millisecond
+			if got := policy.backoff(retry); got < want/2 || got > want {
+				t.Errorf("expected retry %d to wait between %v and %v, got %v", retry, want/2, want, got)
+			}
+		}
+	})
+
+	t.Run("given max delay when backing off then caps the delay", func(t *testing.T) {
+		policy := RetryPolicy{MaxRetries: 5, BaseDelay: 100 * time.Millisecond, MaxDelay: 300 * time.Millisecond}
+
+		if got := policy.backoff(4); got < 150*time.Millisecond || got > 300*time.Millisecond {
+			t.Errorf("expected a wait between 150ms and 300ms, got %v", got)
+		}
+	})
+}
@@ -0,0 +1,84 @@
+package state
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/planetargon/harvest-tui/internal/harvest"
+)
+
+// ProjectCache is the last fetched list of projects and tasks, kept next to
+// state.json so startup can show it while a fresh list loads.
+type ProjectCache struct {
+	AccountID string                     `json:"account_id"`
+	FetchedAt time.Time                  `json:"fetched_at"`
+	Projects  []harvest.ProjectWithTasks `json:"projects"`
+}
+
+// LoadProjectCache reads the cached projects for accountID. It returns nil
+// without an error when there is no cache yet or it belongs to another account.
+func LoadProjectCache(accountID string) (*ProjectCache, error) {
+	cachePath, err := getProjectCachePath()
+	if err != nil {
+		return nil, fmt.Errorf("could not determine project cache path: %w", err)
+	}
+
+	data, err := os.ReadFile(cachePath)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("could not read project cache: %w", err)
+	}
+
+	var cache ProjectCache
+	if err := json.Unmarshal(data, &cache); err != nil {
+		return nil, fmt.Errorf("could not parse project cache: %w", err)
+	}
+
+	if cache.AccountID != accountID {
+		return nil, nil
+	}
+
+	return &cache, nil
+}
+
+// SaveProjectCache writes projects as the cache for accountID.
+func SaveProjectCache(accountID string, projects []harvest.ProjectWithTasks) error {
+	cachePath, err := getProjectCachePath()
+	if err != nil {
+		return fmt.Errorf("could not determine project cache path: %w", err)
+	}
+
+	if err := os.MkdirAll(filepath.Dir(cachePath), 0755); err != nil {
+		return fmt.Errorf("could not create state directory: %w", err)
+	}
+
+	cache := ProjectCache{AccountID: accountID, FetchedAt: time.Now(), Projects: projects}
+	data, err := json.MarshalIndent(cache, "", "  ")
+	if err != nil {
+		return fmt.Errorf("could not marshal project cache: %w", err)
+	}
+
+	// Write then rename so a crash mid-write never leaves a truncated cache
+	tmpPath := cachePath + ".tmp"
+	if err := os.WriteFile(tmpPath, data, 0644); err != nil {
+		return fmt.Errorf("could not write project cache: %w", err)
+	}
+	if err := os.Rename(tmpPath, cachePath); err != nil {
+		return fmt.Errorf("could not write project cache: %w", err)
+	}
+
+	return nil
+}
+
+func getProjectCachePath() (string, error) {
+	statePath, err := getStatePath()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(filepath.Dir(statePath), "projects.json"), nil
+}
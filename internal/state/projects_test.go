@@ -0,0 +1,85 @@
+package state
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/planetargon/harvest-tui/internal/harvest"
+)
+
+func TestProjectCache(t *testing.T) {
+	projects := []harvest.ProjectWithTasks{
+		{
+			Project: harvest.Project{ID: 10, Name: "Website", Client: harvest.ProjectClient{ID: 1, Name: "Acme"}},
+			Tasks:   []harvest.Task{{ID: 100, Name: "Development", Billable: true}},
+		},
+	}
+
+	t.Run("given saved projects when loaded for the same account then returns them", func(t *testing.T) {
+		t.Setenv("HOME", t.TempDir())
+
+		if err := SaveProjectCache("12345", projects); err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+
+		cache, err := LoadProjectCache("12345")
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		if cache == nil || len(cache.Projects) != 1 {
+			t.Fatalf("expected one cached project, got %+v", cache)
+		}
+		got := cache.Projects[0]
+		if got.Project.Client.Name != "Acme" || got.Tasks[0].Name != "Development" || !got.Tasks[0].Billable {
+			t.Errorf("expected project and task to round-trip, got %+v", got)
+		}
+		if cache.FetchedAt.IsZero() {
+			t.Error("expected fetch time to be recorded")
+		}
+	})
+
+	t.Run("given saved projects when loaded for another account then returns nil", func(t *testing.T) {
+		t.Setenv("HOME", t.TempDir())
+
+		if err := SaveProjectCache("12345", projects); err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+
+		cache, err := LoadProjectCache("99999")
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		if cache != nil {
+			t.Errorf("expected no cache for another account, got %+v", cache)
+		}
+	})
+
+	t.Run("given no cache file when loaded then returns nil", func(t *testing.T) {
+		t.Setenv("HOME", t.TempDir())
+
+		cache, err := LoadProjectCache("12345")
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		if cache != nil {
+			t.Errorf("expected no cache, got %+v", cache)
+		}
+	})
+
+	t.Run("given corrupt cache file when loaded then returns error", func(t *testing.T) {
+		home := t.TempDir()
+		t.Setenv("HOME", home)
+		cacheDir := filepath.Join(home, ".config", "harvest-tui")
+		if err := os.MkdirAll(cacheDir, 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(filepath.Join(cacheDir, "projects.json"), []byte("{"), 0644); err != nil {
+			t.Fatal(err)
+		}
+
+		if _, err := LoadProjectCache("12345"); err == nil {
+			t.Error("expected parse error")
+		}
+	})
+}
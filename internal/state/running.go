@@ -0,0 +1,110 @@
+package state
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/planetargon/harvest-tui/internal/harvest"
+)
+
+// RunningCache is the last fetched running timer, kept next to state.json so
+// "harvest-tui prompt" can answer without a network call. Entry is nil when
+// no timer was running. CheckedAt is when Harvest was last asked, even if the
+// request failed, so prompts back off while offline.
+type RunningCache struct {
+	AccountID string             `json:"account_id"`
+	FetchedAt time.Time          `json:"fetched_at"`
+	CheckedAt time.Time          `json:"checked_at"`
+	Entry     *harvest.TimeEntry `json:"entry"`
+}
+
+// LastChecked returns when Harvest was last asked for the running timer.
+func (c RunningCache) LastChecked() time.Time {
+	if c.CheckedAt.After(c.FetchedAt) {
+		return c.CheckedAt
+	}
+	return c.FetchedAt
+}
+
+// LoadRunningCache reads the cached running timer for accountID. It returns
+// nil without an error when there is no cache yet or it belongs to another
+// account.
+func LoadRunningCache(accountID string) (*RunningCache, error) {
+	cachePath, err := getRunningCachePath()
+	if err != nil {
+		return nil, fmt.Errorf("could not determine running timer cache path: %w", err)
+	}
+
+	data, err := os.ReadFile(cachePath)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("could not read running timer cache: %w", err)
+	}
+
+	var cache RunningCache
+	if err := json.Unmarshal(data, &cache); err != nil {
+		return nil, fmt.Errorf("could not parse running timer cache: %w", err)
+	}
+
+	if cache.AccountID != accountID {
+		return nil, nil
+	}
+
+	return &cache, nil
+}
+
+// SaveRunningCache writes entry, or nil for no running timer, as the cache
+// for accountID. fetchedAt is when the request for it was made.
+func SaveRunningCache(accountID string, entry *harvest.TimeEntry, fetchedAt time.Time) error {
+	return WriteRunningCache(RunningCache{AccountID: accountID, FetchedAt: fetchedAt, CheckedAt: fetchedAt, Entry: entry})
+}
+
+// WriteRunningCache writes cache as it is, e.g. to record a failed check.
+func WriteRunningCache(cache RunningCache) error {
+	cachePath, err := getRunningCachePath()
+	if err != nil {
+		return fmt.Errorf("could not determine running timer cache path: %w", err)
+	}
+
+	if err := os.MkdirAll(filepath.Dir(cachePath), 0755); err != nil {
+		return fmt.Errorf("could not create state directory: %w", err)
+	}
+
+	data, err := json.Marshal(cache)
+	if err != nil {
+		return fmt.Errorf("could not marshal running timer cache: %w", err)
+	}
+
+	// Prompts in several shells or tmux panes can refresh at once, so each
+	// writes its own temporary file before renaming it into place
+	tmpFile, err := os.CreateTemp(filepath.Dir(cachePath), "running-*.json.tmp")
+	if err != nil {
+		return fmt.Errorf("could not write running timer cache: %w", err)
+	}
+	defer os.Remove(tmpFile.Name())
+	if _, err := tmpFile.Write(data); err != nil {
+		tmpFile.Close()
+		return fmt.Errorf("could not write running timer cache: %w", err)
+	}
+	if err := tmpFile.Close(); err != nil {
+		return fmt.Errorf("could not write running timer cache: %w", err)
+	}
+	if err := os.Rename(tmpFile.Name(), cachePath); err != nil {
+		return fmt.Errorf("could not write running timer cache: %w", err)
+	}
+
+	return nil
+}
+
+func getRunningCachePath() (string, error) {
+	statePath, err := getStatePath()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(filepath.Dir(statePath), "running.json"), nil
+}
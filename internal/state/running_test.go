@@ -0,0 +1,83 @@
+package state
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/planetargon/harvest-tui/internal/harvest"
+)
+
+func TestRunningCache(t *testing.T) {
+	t.Run("given saved running timer when loaded for the same account then returns it", func(t *testing.T) {
+		t.Setenv("HOME", t.TempDir())
+		entry := &harvest.TimeEntry{ID: 7, Hours: 1.25, IsRunning: true, Client: harvest.TimeEntryClient{Name: "Acme"}}
+
+		if err := SaveRunningCache("12345", entry, time.Now()); err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+
+		cache, err := LoadRunningCache("12345")
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		if cache == nil || cache.Entry == nil || cache.Entry.ID != 7 || cache.Entry.Client.Name != "Acme" {
+			t.Fatalf("expected entry to round-trip, got %+v", cache)
+		}
+		if cache.FetchedAt.IsZero() {
+			t.Error("expected fetch time to be recorded")
+		}
+	})
+
+	t.Run("given saved empty timer when loaded then returns a cache without an entry", func(t *testing.T) {
+		t.Setenv("HOME", t.TempDir())
+
+		if err := SaveRunningCache("12345", nil, time.Now()); err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+
+		cache, err := LoadRunningCache("12345")
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		if cache == nil || cache.Entry != nil {
+			t.Errorf("expected a cache recording no timer, got %+v", cache)
+		}
+	})
+
+	t.Run("given saved timer when loaded for another account then returns nil", func(t *testing.T) {
+		t.Setenv("HOME", t.TempDir())
+
+		if err := SaveRunningCache("12345", &harvest.TimeEntry{ID: 7}, time.Now()); err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+
+		cache, err := LoadRunningCache("99999")
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		if cache != nil {
+			t.Errorf("expected no cache for another account, got %+v", cache)
+		}
+	})
+
+	t.Run("given saved timer when saved again then leaves no temporary files", func(t *testing.T) {
+		home := t.TempDir()
+		t.Setenv("HOME", home)
+
+		for range 2 {
+			if err := SaveRunningCache("12345", nil, time.Now()); err != nil {
+				t.Fatalf("expected no error, got %v", err)
+			}
+		}
+
+		files, err := os.ReadDir(filepath.Join(home, ".config", "harvest-tui"))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(files) != 1 || files[0].Name() != "running.json" {
+			t.Errorf("expected only running.json, got %v", files)
+		}
+	})
+}
@@ -0,0 +1,61 @@
+package tui
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/planetargon/harvest-tui/internal/harvest"
+)
+
+func TestDescribeError(t *testing.T) {
+	t.Run("given API error with Harvest message when described then returns the message", func(t *testing.T) {
+		err := &harvest.APIError{StatusCode: 422, Message: "Cannot edit approved entry"}
+
+		if got := describeError(err); got != "Cannot edit approved entry" {
+			t.Errorf("expected Harvest message, got %q", got)
+		}
+	})
+
+	t.Run("given rate limited API error with Retry-After when described then mentions the wait", func(t *testing.T) {
+		err := &harvest.APIError{StatusCode: 429, RetryAfter: 12 * time.Second}
+
+		got := describeError(err)
+		if !strings.Contains(got, "rate limited") || !strings.Contains(got, "12s") {
+			t.Errorf("expected rate limit description with wait, got %q", got)
+		}
+	})
+
+	t.Run("given unauthorized API error without message when described then points at credentials", func(t *testing.T) {
+		err := &harvest.APIError{StatusCode: 401}
+
+		if got := describeError(err); !strings.Contains(got, "access token") {
+			t.Errorf("expected credentials hint, got %q", got)
+		}
+	})
+
+	t.Run("given plain error when described then returns its text", func(t *testing.T) {
+		if got := describeError(errors.New("network request failed")); got != "network request failed" {
+			t.Errorf("expected original text, got %q", got)
+		}
+	})
+}
+
+func TestAPIErrorStatusLine(t *testing.T) {
+	t.Run("given update rejected by Harvest when message received then status line shows the human message", func(t *testing.T) {
+		model := newTestModel()
+		model.currentView = ViewEditEntry
+		model.editingEntry = &harvest.TimeEntry{ID: 1}
+
+		newModel, _ := model.Update(timeEntryUpdatedMsg{err: &harvest.APIError{StatusCode: 422, Message: "Cannot edit approved entry"}})
+		m := newModel.(Model)
+
+		if m.statusMessage != "Failed to update entry: Cannot edit approved entry" {
+			t.Errorf("unexpected status message %q", m.statusMessage)
+		}
+		if !strings.Contains(m.View(), "Cannot edit approved entry") {
+			t.Error("expected rendered view to contain the Harvest message")
+		}
+	})
+}
@@ -1,9 +1,11 @@
 package tui
 
 import (
+	"context"
+	"errors"
 	"fmt"
+	"math"
 	"sort"
-	"strconv"
 	"strings"
 	"time"
 
@@ -14,6 +16,7 @@ import (
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
 	"github.com/planetargon/harvest-tui/internal/config"
+	"github.com/planetargon/harvest-tui/internal/duration"
 	"github.com/planetargon/harvest-tui/internal/harvest"
 	"github.com/planetargon/harvest-tui/internal/state"
 )
@@ -44,6 +47,12 @@ const (
 	ViewDurationInput
 	// ViewBillableToggle is the view for toggling billable status for a new time entry.
 	ViewBillableToggle
+	// ViewWeek is the weekly timesheet grid showing project/task rows by day.
+	ViewWeek
+	// ViewForgottenTimer is the blocking prompt about a timer left running.
+	ViewForgottenTimer
+	// ViewExport is the form exporting a date range to CSV.
+	ViewExport
 )
 
 // Model represents the state of the TUI application.
@@ -53,7 +62,7 @@ type Model struct {
 
 	// Configuration and external dependencies
 	config        *config.Config
-	harvestClient *harvest.Client
+	harvestClient harvest.API
 	appState      *state.State
 
 	// Data
@@ -62,6 +71,10 @@ type Model struct {
 	projectsWithTasks  []harvest.ProjectWithTasks
 	selectedEntryIndex int
 	currentUser        *harvest.User
+	company            harvest.Company    // Account settings for hour, time, date and week formats
+	runningEntry       *harvest.TimeEntry // Timer running on any date, nil when none
+	runningFetchTime   time.Time          // When runningEntry was fetched, for its live elapsed time
+	keptTimerID        int                // Timer the user chose to keep running; not asked about again
 
 	// New entry creation state
 	selectedProject      *harvest.Project
@@ -73,22 +86,48 @@ type Model struct {
 
 	// Edit entry state
 	editingEntry     *harvest.TimeEntry
+	editProject      *harvest.Project
 	editTask         *harvest.Task
+	editDate         time.Time
 	editNotes        string
 	editHours        string
 	editBillable     bool
-	editCurrentField int // 0=task, 1=notes, 2=duration
-	pendingTaskEdit  bool
+	editCurrentField int  // 0=project, 1=task, 2=date, 3=notes, 4=duration, 5=billable
+	pendingTaskEdit  bool // Open project or task selection once projects load
+
+	// Week view state
+	weekStart     time.Time // First day of the week shown in ViewWeek, per the account's week start
+	weekEntries   []harvest.TimeEntry
+	weekLoading   bool
+	weekFetchTime time.Time // Track last week fetch for live running timer display
+	weekSyncedAt  time.Time // When weekEntries were fetched, for incremental syncs
+	weekLoadedAt  time.Time // When weekEntries were last fetched in full
+	weekRowIndex  int
+	weekColIndex  int // 0=first day of the account's week ... 6=last day
+
+	// Forgotten timer prompt state, nil when not shown
+	forgottenTimer *forgottenTimerPrompt
+
+	// Export form state, nil when not shown
+	exportForm *exportForm
 
 	// UI state
-	loading           bool
-	errorMessage      string
-	statusMessage     string
-	statusMessageTime time.Time // Track when the status message was set
-	lastFetchTime     time.Time // Track last API fetch to avoid rate limiting
-	spinner           spinner.Model
-	timeEntriesLoaded bool
-	projectsLoaded    bool
+	loading            bool
+	errorMessage       string
+	statusMessage      string
+	statusMessageTime  time.Time          // Track when the status message was set
+	lastFetchTime      time.Time          // Track last API fetch to pace running timer refreshes
+	entriesSyncedAt    time.Time          // When timeEntries were fetched, for incremental syncs
+	entriesLoadedAt    time.Time          // When timeEntries were last fetched in full
+	cancelFetch        context.CancelFunc // Cancels the in-flight time entries fetch
+	cancelWeekFetch    context.CancelFunc // Cancels the in-flight week entries fetch
+	throttled          bool               // Requests are waiting on the client-side rate limit
+	ticking            bool               // A tick is pending, so the tick loop is running
+	spinner            spinner.Model
+	timeEntriesLoaded  bool
+	projectsLoaded     bool
+	refreshingProjects bool // A refresh requested with R is in flight
+	selectEntryID      int  // Entry to select once the day's entries load
 
 	// List components for selection views
 	projectList list.Model
@@ -105,8 +144,9 @@ type Model struct {
 	height int
 }
 
-// NewModel creates a new TUI model with the given configuration.
-func NewModel(cfg *config.Config, client *harvest.Client, appState *state.State, user *harvest.User) Model {
+// NewModel creates a new TUI model with the given configuration. client is
+// usually a *harvest.Client, but any harvest.API such as harvestfake works.
+func NewModel(cfg *config.Config, client harvest.API, appState *state.State, user *harvest.User) Model {
 	s := spinner.New()
 	s.Spinner = spinner.MiniDot
 	s.Style = lipgloss.NewStyle().Foreground(accentColor)
@@ -131,6 +171,7 @@ func NewModel(cfg *config.Config, client *harvest.Client, appState *state.State,
 		errorMessage:       "",
 		statusMessage:      "",
 		statusMessageTime:  time.Time{},
+		ticking:            true, // Init starts the tick loop
 		spinner:            s,
 		projectList:        newShellList(newProjectDelegate()),
 		taskList:           newShellList(newTaskDelegate()),
@@ -143,8 +184,9 @@ func NewModel(cfg *config.Config, client *harvest.Client, appState *state.State,
 func (m Model) Init() tea.Cmd {
 	return tea.Batch(
 		m.spinner.Tick,
-		fetchTimeEntriesCmd(m.harvestClient, m.currentDate),
+		fetchTimeEntriesCmd(context.Background(), m.harvestClient, m.currentDate),
 		fetchProjectsWithTasksCmd(m.harvestClient),
+		fetchRunningEntryCmd(m.harvestClient),
 		tickCmd(), // Start the ticker for real-time updates
 	)
 }
@@ -169,13 +211,37 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		}
 		return m, nil
 
+	case ThrottleMsg:
+		m.throttled = msg.Throttled
+		return m, nil
+
 	case timeEntriesFetchedMsg:
+		// A cancelled fetch was superseded by a newer one that is still in flight
+		if errors.Is(msg.err, context.Canceled) {
+			return m, nil
+		}
+		// Drop late responses for a date the user has already navigated away from
+		if msg.date != m.currentDate.Format("2006-01-02") {
+			return m, nil
+		}
 		if msg.err != nil {
-			m.errorMessage = "Failed to fetch time entries: " + msg.err.Error()
+			m.errorMessage = "Failed to fetch time entries: " + describeError(msg.err)
 		} else {
 			m.timeEntries = msg.entries
 			m.errorMessage = ""
 			m.lastFetchTime = time.Now()
+			m.entriesSyncedAt = msg.fetchedAt
+			m.entriesLoadedAt = msg.fetchedAt
+			m.reconcileRunningEntry()
+			if m.selectEntryID != 0 {
+				for i, entry := range m.timeEntries {
+					if entry.ID == m.selectEntryID {
+						m.selectedEntryIndex = i
+						break
+					}
+				}
+				m.selectEntryID = 0
+			}
 		}
 		m.loading = false
 		m.timeEntriesLoaded = true
@@ -183,11 +249,70 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		// Transition from loading screen when both fetches complete
 		if m.currentView == ViewLoading && m.projectsLoaded {
 			m.currentView = ViewList
+			m.checkForgottenTimer()
 		}
 
 		// If there's a running timer, continue ticking
 		if m.hasRunningTimer() {
-			return m, tickCmd()
+			return m, m.startTicking()
+		}
+		return m, nil
+
+	case runningEntryFetchedMsg:
+		// The badge is a convenience, so keep the last known timer on errors
+		if msg.err != nil {
+			return m, nil
+		}
+		m.setRunningEntry(msg.entry)
+		m.checkForgottenTimer()
+		if msg.entry != nil {
+			return m, m.startTicking()
+		}
+		return m, nil
+
+	case forgottenTimerStoppedMsg:
+		m.applyForgottenTimerStopped(msg)
+		return m, nil
+
+	case entriesExportedMsg:
+		m.applyEntriesExported(msg)
+		return m, nil
+
+	case timeEntriesSyncedMsg:
+		cmd := m.applyTimeEntriesSync(msg)
+		if m.hasRunningTimer() {
+			return m, tea.Batch(cmd, m.startTicking())
+		}
+		return m, cmd
+
+	case weekEntriesSyncedMsg:
+		cmd := m.applyWeekSync(msg)
+		if m.weekHasRunningTimer() {
+			return m, tea.Batch(cmd, m.startTicking())
+		}
+		return m, cmd
+
+	case weekEntriesFetchedMsg:
+		// Ignore responses for a week the user has already navigated away from
+		if !msg.weekStart.Equal(m.weekStart) || errors.Is(msg.err, context.Canceled) {
+			return m, nil
+		}
+		if msg.err != nil {
+			m.errorMessage = "Failed to fetch time entries: " + describeError(msg.err)
+		} else {
+			m.weekEntries = msg.entries
+			m.errorMessage = ""
+			m.weekFetchTime = time.Now()
+			m.weekSyncedAt = msg.fetchedAt
+			m.weekLoadedAt = msg.fetchedAt
+			if rowCount := len(m.weekRows()); m.weekRowIndex >= rowCount {
+				m.weekRowIndex = max(rowCount-1, 0)
+			}
+		}
+		m.weekLoading = false
+
+		if m.weekHasRunningTimer() {
+			return m, m.startTicking()
 		}
 		return m, nil
 
@@ -200,14 +325,24 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			}
 		}
 
+		// Ask about a timer that has run past the configured limits
+		m.checkForgottenTimer()
+
 		// Check if we have a running timer and it's time to refresh from API
 		if m.hasRunningTimer() && m.currentView == ViewList && !m.loading {
 			if time.Since(m.lastFetchTime) >= 25*time.Second {
+				m.timeEntries = accrueRunningHours(m.timeEntries, m.lastFetchTime)
 				m.lastFetchTime = time.Now()
-				return m, tea.Batch(
-					fetchTimeEntriesCmd(m.harvestClient, m.currentDate),
-					tickCmd(),
-				)
+				return m, tea.Batch(m.refreshTimeEntriesCmd(), fetchRunningEntryCmd(m.harvestClient), tickCmd())
+			}
+			return m, tickCmd()
+		}
+		// Refresh the weekly grid while a timer is running in it
+		if m.weekHasRunningTimer() && m.currentView == ViewWeek && !m.weekLoading {
+			if time.Since(m.weekFetchTime) >= 25*time.Second {
+				m.weekEntries = accrueRunningHours(m.weekEntries, m.weekFetchTime)
+				m.weekFetchTime = time.Now()
+				return m, tea.Batch(m.refreshWeekEntriesCmd(), fetchRunningEntryCmd(m.harvestClient), tickCmd())
 			}
 			return m, tickCmd()
 		}
@@ -215,36 +350,51 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		if m.hasRunningTimer() || m.statusMessage != "" {
 			return m, tickCmd()
 		}
+		m.ticking = false
 		return m, nil
 
 	case projectsWithTasksFetchedMsg:
+		var cmd tea.Cmd
 		if msg.err != nil {
-			m.errorMessage = "Failed to fetch projects: " + msg.err.Error()
+			// Keep working from the cache when it has projects to offer
+			if len(m.projectsWithTasks) > 0 {
+				m.setStatusMessage("Could not refresh projects: " + describeError(msg.err))
+			} else {
+				m.errorMessage = "Failed to fetch projects: " + describeError(msg.err)
+			}
 			m.pendingTaskEdit = false
 		} else {
-			m.projectsWithTasks = msg.projectsWithTasks
+			m.applyFreshProjects(msg.projectsWithTasks)
 			m.errorMessage = ""
+			if m.refreshingProjects {
+				m.setStatusMessage(projectsRefreshedStatus(len(msg.projectsWithTasks)))
+			}
+			cmd = saveProjectCacheCmd(m.config.Harvest.AccountID, msg.projectsWithTasks)
 
 			// If user requested task edit while projects were loading, open it now
 			if m.pendingTaskEdit && m.editingEntry != nil && m.currentView == ViewEditEntry {
 				m.pendingTaskEdit = false
-				if !m.openTaskSelectionForEdit() {
+				if m.editCurrentField == 0 {
+					m.openProjectSelectionForEdit()
+				} else if !m.openTaskSelectionForEdit() {
 					m.setStatusMessage("No tasks found for this project")
 				}
 			}
 		}
 		m.projectsLoaded = true
+		m.refreshingProjects = false
 
 		// Transition from loading screen when both fetches complete
 		if m.currentView == ViewLoading && m.timeEntriesLoaded {
 			m.currentView = ViewList
+			m.checkForgottenTimer()
 		}
 
-		return m, nil
+		return m, cmd
 
 	case timeEntryStartedMsg:
 		if msg.err != nil {
-			m.setStatusMessage("Failed to start timer: " + msg.err.Error())
+			m.setStatusMessage("Failed to start timer: " + describeError(msg.err))
 		} else {
 			// Update the entry in our local list
 			for i, entry := range m.timeEntries {
@@ -253,19 +403,17 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 					break
 				}
 			}
+			m.setRunningEntry(msg.entry)
 			m.setStatusMessage("Timer started successfully")
 			// Re-fetch entries so previously running timer shows as stopped
 			m.lastFetchTime = time.Now()
-			return m, tea.Batch(
-				fetchTimeEntriesCmd(m.harvestClient, m.currentDate),
-				tickCmd(),
-			)
+			return m, tea.Batch(m.refreshTimeEntriesCmd(), m.startTicking())
 		}
 		return m, nil
 
 	case timeEntryStoppedMsg:
 		if msg.err != nil {
-			m.setStatusMessage("Failed to stop timer: " + msg.err.Error())
+			m.setStatusMessage("Failed to stop timer: " + describeError(msg.err))
 		} else {
 			// Update the entry in our local list
 			for i, entry := range m.timeEntries {
@@ -274,35 +422,55 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 					break
 				}
 			}
+			if m.runningEntry != nil && m.runningEntry.ID == msg.entry.ID {
+				m.setRunningEntry(nil)
+			}
 			m.setStatusMessage("Timer stopped successfully")
 		}
 		return m, nil
 
 	case timeEntryCreatedMsg:
 		if msg.err != nil {
-			m.setStatusMessage("Failed to create entry: " + msg.err.Error())
+			m.setStatusMessage("Failed to create entry: " + describeError(msg.err))
 		} else {
 			// Add the new entry to our local list
 			m.timeEntries = append([]harvest.TimeEntry{*msg.entry}, m.timeEntries...)
-			m.setStatusMessage("Time entry created successfully")
 			// Clear new entry state and return to main list
 			m.clearEditState()
 			m.currentView = ViewList
+			if msg.entry.IsRunning {
+				m.setRunningEntry(msg.entry)
+				m.setStatusMessage("Timer started successfully")
+				// Re-fetch entries so the previously running timer shows as stopped
+				m.lastFetchTime = time.Now()
+				return m, tea.Batch(m.refreshTimeEntriesCmd(), m.startTicking())
+			}
+			m.setStatusMessage("Time entry created successfully")
 		}
 		return m, nil
 
 	case timeEntryUpdatedMsg:
 		if msg.err != nil {
-			m.setStatusMessage("Failed to update entry: " + msg.err.Error())
+			m.setStatusMessage("Failed to update entry: " + describeError(msg.err))
 		} else {
-			// Update the entry in our local list
-			for i, entry := range m.timeEntries {
-				if entry.ID == msg.entry.ID {
-					m.timeEntries[i] = *msg.entry
-					break
+			if msg.entry.SpentDate != "" && msg.entry.SpentDate != m.currentDate.Format("2006-01-02") {
+				// The entry moved to another day, so drop it from this one
+				m.removeTimeEntry(msg.entry.ID)
+				movedTo := msg.entry.SpentDate
+				if date, err := time.Parse("2006-01-02", movedTo); err == nil {
+					movedTo = date.Format("Mon, " + m.shortDateLayout())
 				}
+				m.setStatusMessage("Time entry moved to " + movedTo)
+			} else {
+				// Update the entry in our local list
+				for i, entry := range m.timeEntries {
+					if entry.ID == msg.entry.ID {
+						m.timeEntries[i] = *msg.entry
+						break
+					}
+				}
+				m.setStatusMessage("Time entry updated successfully")
 			}
-			m.setStatusMessage("Time entry updated successfully")
 			// Clear edit state and return to main list
 			m.clearEditState()
 			m.currentView = ViewList
@@ -311,21 +479,10 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 
 	case timeEntryDeletedMsg:
 		if msg.err != nil {
-			m.setStatusMessage("Failed to delete entry: " + msg.err.Error())
+			m.setStatusMessage("Failed to delete entry: " + describeError(msg.err))
 		} else {
 			// Remove the entry from our local list
-			newEntries := []harvest.TimeEntry{}
-			for _, entry := range m.timeEntries {
-				if entry.ID != msg.entryID {
-					newEntries = append(newEntries, entry)
-				}
-			}
-			m.timeEntries = newEntries
-
-			// Adjust selected index if necessary
-			if m.selectedEntryIndex >= len(m.timeEntries) && m.selectedEntryIndex > 0 {
-				m.selectedEntryIndex--
-			}
+			m.removeTimeEntry(msg.entryID)
 
 			m.setStatusMessage("Time entry deleted successfully")
 			// Clear edit state and return to main list
@@ -364,6 +521,12 @@ func (m Model) View() string {
 		return m.renderDurationInputView()
 	case ViewBillableToggle:
 		return m.renderBillableToggleView()
+	case ViewWeek:
+		return m.renderWeekView()
+	case ViewForgottenTimer:
+		return m.renderForgottenTimerView()
+	case ViewExport:
+		return m.renderExportView()
 	default:
 		return "Unknown view"
 	}
@@ -383,6 +546,16 @@ func (m Model) handleKeyPress(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 		return m, nil
 	}
 
+	// The forgotten timer prompt blocks until it is answered
+	if m.currentView == ViewForgottenTimer {
+		return m.handleForgottenTimerKeys(msg)
+	}
+
+	// The export form's fields take every key, "?" included
+	if m.currentView == ViewExport {
+		return m.handleExportKeys(msg)
+	}
+
 	// Global keybindings that work in all views
 	switch msg.String() {
 	case "?":
@@ -420,6 +593,8 @@ func (m Model) handleKeyPress(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 		result, cmd = m.handleDurationInputKeys(msg)
 	case ViewBillableToggle:
 		result, cmd = m.handleBillableToggleKeys(msg)
+	case ViewWeek:
+		result, cmd = m.handleWeekViewKeys(msg)
 	default:
 		return m, nil
 	}
@@ -443,7 +618,9 @@ func (m *Model) clearEditState() {
 	m.notesInput = nil
 	m.durationInput = nil
 	m.editingEntry = nil
+	m.editProject = nil
 	m.editTask = nil
+	m.editDate = time.Time{}
 	m.editNotes = ""
 	m.editHours = ""
 	m.editBillable = true
@@ -453,11 +630,26 @@ func (m *Model) clearEditState() {
 	m.pendingTaskEdit = false
 }
 
+// removeTimeEntry removes an entry from the day's list, keeping the selection in range.
+func (m *Model) removeTimeEntry(id int) {
+	newEntries := []harvest.TimeEntry{}
+	for _, entry := range m.timeEntries {
+		if entry.ID != id {
+			newEntries = append(newEntries, entry)
+		}
+	}
+	m.timeEntries = newEntries
+
+	// Adjust selected index if necessary
+	if m.selectedEntryIndex >= len(m.timeEntries) && m.selectedEntryIndex > 0 {
+		m.selectedEntryIndex--
+	}
+}
+
 // formatHoursSimple formats hours as H:MM format.
 func formatHoursSimple(hours float64) string {
-	h := int(hours)
-	m := int((hours - float64(h)) * 60)
-	return fmt.Sprintf("%d:%02d", h, m)
+	minutes := int(math.Round(hours * 60))
+	return fmt.Sprintf("%d:%02d", minutes/60, minutes%60)
 }
 
 // truncateString truncates a string to the given max length, adding "..." if truncated.
@@ -468,35 +660,6 @@ func truncateString(s string, maxLen int) string {
 	return s[:maxLen-3] + "..."
 }
 
-// parseDuration parses a duration string in HH:MM format and returns hours as a float64.
-func parseDuration(durationStr string) (float64, error) {
-	durationStr = strings.TrimSpace(durationStr)
-	if durationStr == "" {
-		return 0, fmt.Errorf("duration cannot be empty")
-	}
-
-	parts := strings.Split(durationStr, ":")
-	if len(parts) != 2 {
-		return 0, fmt.Errorf("invalid duration format. Use HH:MM (e.g., 1:30)")
-	}
-
-	hours, err := strconv.Atoi(parts[0])
-	if err != nil {
-		return 0, fmt.Errorf("invalid duration format. Use HH:MM (e.g., 1:30)")
-	}
-
-	minutes, err := strconv.Atoi(parts[1])
-	if err != nil {
-		return 0, fmt.Errorf("invalid duration format. Use HH:MM (e.g., 1:30)")
-	}
-
-	if hours < 0 || minutes < 0 || minutes >= 60 {
-		return 0, fmt.Errorf("invalid duration format. Use HH:MM (e.g., 1:30)")
-	}
-
-	return float64(hours) + float64(minutes)/60.0, nil
-}
-
 // projectItem represents a project in the selection list.
 type projectItem struct {
 	project harvest.Project
@@ -568,6 +731,9 @@ func newTaskDelegate() list.DefaultDelegate {
 func (m *Model) setListSizes() {
 	contentW := m.shellWidth() - 4
 	contentH := m.height - 7
+	if m.runningEntry != nil {
+		contentH-- // The running timer badge under the title bar
+	}
 	if contentH < 5 {
 		contentH = 5
 	}
@@ -647,7 +813,12 @@ func (m Model) renderProjectSelectView() string {
 	titleBar := m.renderTitleBar()
 
 	// Breadcrumb header
-	breadcrumb := "  " + AccentText.Render("New Time Entry") + ArrowStyle.Render(" → ") + MutedText.Render("Step 1: Choose Project")
+	var breadcrumb string
+	if m.editingEntry != nil {
+		breadcrumb = "  " + AccentText.Render("Edit Time Entry") + ArrowStyle.Render(" → ") + MutedText.Render("Change Project")
+	} else {
+		breadcrumb = "  " + AccentText.Render("New Time Entry") + ArrowStyle.Render(" → ") + MutedText.Render("Step 1: Choose Project")
+	}
 
 	divider := "  " + RenderDividerWidth(width-4)
 
@@ -712,28 +883,40 @@ func (m Model) renderEditView() string {
 	// Breadcrumb
 	breadcrumb := "  " + AccentText.Render("Edit Time Entry")
 
-	// Entry info (client → project breadcrumb, task is now an editable field)
-	info := ""
-	if m.editingEntry != nil {
-		info = "  " + MutedText.Render(fmt.Sprintf("%s → %s",
-			m.editingEntry.Client.Name,
-			m.editingEntry.Project.Name))
-	}
-
 	divider := "  " + RenderDividerWidth(width-4)
 
 	// Build field views
-	taskLabel := fieldLabel("Task:", m.editCurrentField == 0)
+	projectLabel := fieldLabel("Project:", m.editCurrentField == 0)
+	projectView := ""
+	if m.editProject != nil {
+		projectView = fmt.Sprintf("%s → %s", m.editProject.Client.Name, m.editProject.Name)
+	} else if m.editingEntry != nil {
+		projectView = fmt.Sprintf("%s → %s", m.editingEntry.Client.Name, m.editingEntry.Project.Name)
+	}
+	if m.editCurrentField == 0 {
+		projectView += MutedText.Render("  (press enter to change)")
+	}
+
+	taskLabel := fieldLabel("Task:", m.editCurrentField == 1)
 	taskName := ""
 	if m.editTask != nil {
 		taskName = m.editTask.Name
 	}
 	taskView := taskName
-	if m.editCurrentField == 0 {
+	if m.editCurrentField == 1 {
 		taskView = taskName + MutedText.Render("  (press enter to change)")
 	}
 
-	notesLabel := fieldLabel("Notes:", m.editCurrentField == 1)
+	dateLabel := fieldLabel("Date:", m.editCurrentField == 2)
+	dateView := ""
+	if !m.editDate.IsZero() {
+		dateView = m.editDate.Format(m.titleDateLayout())
+	}
+	if m.editCurrentField == 2 {
+		dateView = ArrowNavStyle.Render("◀ ") + dateView + ArrowNavStyle.Render(" ▶")
+	}
+
+	notesLabel := fieldLabel("Notes:", m.editCurrentField == 3)
 	var notesView string
 	if m.editNotesInput != nil {
 		notesView = m.editNotesInput.View()
@@ -741,7 +924,7 @@ func (m Model) renderEditView() string {
 		notesView = m.editNotes
 	}
 
-	durationLabel := fieldLabel("Duration:", m.editCurrentField == 2)
+	durationLabel := fieldLabel("Duration:", m.editCurrentField == 4)
 	var durationView string
 	if m.editDurationInput != nil {
 		durationView = m.editDurationInput.View()
@@ -749,21 +932,38 @@ func (m Model) renderEditView() string {
 		durationView = m.editHours
 	}
 
+	billableLabel := fieldLabel("Billable:", m.editCurrentField == 5)
+	billableView := renderBillableCheckbox(m.editBillable)
+
 	// Status message if any
 	statusLine := m.renderStatusLine()
 
 	contentLines := []string{
 		titleBar,
 		breadcrumb,
-		info,
 		divider,
 		"",
+		"  " + projectLabel + " " + projectView,
+		"",
 		"  " + taskLabel + " " + taskView,
 		"",
+		"  " + dateLabel + " " + dateView,
+		"",
 		"  " + notesLabel + " " + notesView,
 		"",
 		"  " + durationLabel + " " + durationView,
 	}
+	// Preview the parsed duration under the input, aligned with it
+	if m.editingEntry != nil {
+		if preview := m.renderDurationPreview(m.editHours, m.editingEntry.Hours); preview != "" {
+			indent := strings.Repeat(" ", 3+lipgloss.Width(durationLabel))
+			contentLines = append(contentLines, indent+preview)
+		}
+	}
+	contentLines = append(contentLines,
+		"",
+		"  "+billableLabel+" "+billableView,
+	)
 	if statusLine != "" {
 		contentLines = append(contentLines, "", statusLine)
 	}
@@ -773,9 +973,15 @@ func (m Model) renderEditView() string {
 	footerKeys := []string{
 		RenderKeybinding("tab", "next field"),
 	}
-	if m.editCurrentField == 0 {
+	if m.editCurrentField == 0 || m.editCurrentField == 1 {
 		footerKeys = append(footerKeys, RenderKeybinding("enter", "select"))
 	}
+	if m.editCurrentField == 2 {
+		footerKeys = append(footerKeys, RenderKeybinding("←→", "day"), RenderKeybinding("[]", "week"))
+	}
+	if m.editCurrentField == 5 {
+		footerKeys = append(footerKeys, RenderKeybinding("space", "toggle"))
+	}
 	footerKeys = append(footerKeys,
 		RenderKeybinding("ctrl+s", "save"),
 		RenderKeybinding("esc", "cancel"),
@@ -784,6 +990,14 @@ func (m Model) renderEditView() string {
 	return m.buildShellBox(content, width, footerKeys)
 }
 
+// renderBillableCheckbox renders a billable status as a checkbox.
+func renderBillableCheckbox(billable bool) string {
+	if billable {
+		return "[x] Billable"
+	}
+	return "[ ] Non-billable"
+}
+
 // fieldLabel renders a field label with ▶ indicator if active.
 func fieldLabel(label string, active bool) string {
 	if active {
@@ -809,7 +1023,7 @@ func (m Model) renderConfirmDeleteView() string {
 		if m.editingEntry.Notes != "" {
 			detailLines = append(detailLines, "  "+MutedText.Render("Notes: "+m.editingEntry.Notes))
 		}
-		detailLines = append(detailLines, "  "+MutedText.Render("Duration: "+formatHoursSimple(m.editingEntry.Hours)))
+		detailLines = append(detailLines, "  "+MutedText.Render("Duration: "+m.formatHours(m.editingEntry.Hours)))
 	}
 
 	contentLines := []string{titleBar, breadcrumb, divider, ""}
@@ -847,12 +1061,21 @@ func (m Model) renderHelpView() string {
 		"    ←/h       Previous day",
 		"    →/l       Next day",
 		"    t         Jump to today",
+		"    w         Weekly timesheet",
+		"    g         Go to running timer",
+		"",
+		"  " + AccentText.Render("Weekly Timesheet"),
+		"    ←/→       Previous/next day",
+		"    [/]       Previous/next week",
+		"    enter     Open the selected day",
 		"",
 		"  " + AccentText.Render("Time Entry Actions"),
 		"    n         New entry",
 		"    e         Edit entry",
 		"    d         Delete entry",
 		"    s         Start/stop timer",
+		"    R         Refresh projects and tasks",
+		"    x         Export entries to CSV",
 		"",
 		"  " + AccentText.Render("General"),
 		"    ?         Toggle this help",
@@ -930,14 +1153,20 @@ func (m Model) renderDurationInputView() string {
 
 	// Input field
 	inputView := ""
+	previewView := ""
 	if m.durationInput != nil {
 		inputView = "  " + m.durationInput.View()
+		if startsTimer(m.durationInput.Value()) {
+			previewView = "  " + MutedText.Render("▶ starts a timer")
+		} else {
+			previewView = "  " + m.renderDurationPreview(m.durationInput.Value(), -1)
+		}
 	}
 
 	// Status message
 	statusLine := m.renderStatusLine()
 
-	contentLines := []string{titleBar, breadcrumb, info, notesInfo, divider, "", inputView}
+	contentLines := []string{titleBar, breadcrumb, info, notesInfo, divider, "", inputView, previewView}
 	if statusLine != "" {
 		contentLines = append(contentLines, "", statusLine)
 	}
@@ -973,17 +1202,16 @@ func (m Model) renderBillableToggleView() string {
 	if m.newEntryNotes != "" {
 		detailLines = append(detailLines, "  "+MutedText.Render("Notes: "+m.newEntryNotes))
 	}
-	if m.newEntryHours != "" {
+	if startsTimer(m.newEntryHours) {
+		detailLines = append(detailLines, "  "+MutedText.Render("Duration: timer starts now"))
+	} else {
 		detailLines = append(detailLines, "  "+MutedText.Render("Duration: "+m.newEntryHours))
 	}
 
 	divider := "  " + RenderDividerWidth(width-4)
 
 	// Billable toggle
-	billableStatus := "  [ ] Non-billable"
-	if m.newEntryBillable {
-		billableStatus = "  [x] Billable"
-	}
+	billableStatus := "  " + renderBillableCheckbox(m.newEntryBillable)
 
 	contentLines := []string{titleBar, breadcrumb, info}
 	contentLines = append(contentLines, detailLines...)
@@ -1046,21 +1274,34 @@ func (m Model) handleListViewKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 		m.selectedEntryIndex = 0
 		m.loading = true
 		m.clearStatusMessage()
-		return m, fetchTimeEntriesCmd(m.harvestClient, m.currentDate)
+		return m, fetchTimeEntriesCmd(m.newTimeEntriesFetchContext(), m.harvestClient, m.currentDate)
 
 	case key.Matches(msg, keys.NextDay):
 		m.currentDate = m.currentDate.AddDate(0, 0, 1)
 		m.selectedEntryIndex = 0
 		m.loading = true
 		m.clearStatusMessage()
-		return m, fetchTimeEntriesCmd(m.harvestClient, m.currentDate)
+		return m, fetchTimeEntriesCmd(m.newTimeEntriesFetchContext(), m.harvestClient, m.currentDate)
 
 	case key.Matches(msg, keys.Today):
 		m.currentDate = time.Now()
 		m.selectedEntryIndex = 0
 		m.loading = true
 		m.clearStatusMessage()
-		return m, fetchTimeEntriesCmd(m.harvestClient, m.currentDate)
+		return m, fetchTimeEntriesCmd(m.newTimeEntriesFetchContext(), m.harvestClient, m.currentDate)
+
+	case key.Matches(msg, keys.Week):
+		m.clearStatusMessage()
+		return m.openWeekView()
+
+	case key.Matches(msg, keys.RefreshProjects):
+		return m.refreshProjects()
+
+	case key.Matches(msg, keys.GoToRunning):
+		return m.jumpToRunningEntry()
+
+	case key.Matches(msg, keys.Export):
+		return m.openExportForm()
 
 	case key.Matches(msg, keys.New):
 		if len(m.projectsWithTasks) > 0 {
@@ -1082,7 +1323,7 @@ func (m Model) handleListViewKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 
 			durationInput := textinput.New()
 			durationInput.SetValue("0:00")
-			durationInput.Placeholder = "Enter duration (e.g., 1:30)"
+			durationInput.Placeholder = m.durationPlaceholder()
 			durationInput.Width = 20
 			m.durationInput = &durationInput
 
@@ -1106,11 +1347,23 @@ func (m Model) handleListViewKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 				return m, nil
 			}
 			m.editingEntry = &selectedEntry
+			m.editProject = &harvest.Project{
+				ID:     selectedEntry.Project.ID,
+				Name:   selectedEntry.Project.Name,
+				Client: harvest.ProjectClient{ID: selectedEntry.Client.ID, Name: selectedEntry.Client.Name},
+			}
 			m.editTask = &harvest.Task{ID: selectedEntry.Task.ID, Name: selectedEntry.Task.Name}
+			m.editDate = m.currentDate
+			if spentDate, err := time.ParseInLocation("2006-01-02", selectedEntry.SpentDate, time.Local); err == nil {
+				m.editDate = spentDate
+			}
 			m.editNotes = selectedEntry.Notes
-			m.editHours = formatHoursSimple(selectedEntry.Hours)
+			m.editHours = m.formatHours(selectedEntry.Hours)
+			if r, ok := entryTimeRange(selectedEntry); ok {
+				m.editHours = r.String()
+			}
 			m.editBillable = selectedEntry.IsBillable
-			m.editCurrentField = 0
+			m.editCurrentField = 1 // Start on the task, the most common change
 
 			// Initialize text inputs for editing
 			notesInput := textinput.New()
@@ -1120,7 +1373,7 @@ func (m Model) handleListViewKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 			m.editNotesInput = &notesInput
 
 			durationInput := textinput.New()
-			durationInput.SetValue(formatHoursSimple(selectedEntry.Hours))
+			durationInput.SetValue(m.editHours)
 			durationInput.Placeholder = "Enter duration (e.g., 1:30)"
 			durationInput.Width = 20
 			m.editDurationInput = &durationInput
@@ -1180,6 +1433,12 @@ func (m Model) handleProjectSelectKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 		if m.projectList.FilterState() != list.Unfiltered {
 			break
 		}
+		if m.editingEntry != nil {
+			// Return to edit view, keeping the current project
+			m.currentView = ViewEditEntry
+			m.updateEditFieldFocus()
+			return m, nil
+		}
 		// Check if we're coming from new entry form
 		if m.newEntryCurrentField >= 0 && m.newEntryCurrentField <= 3 {
 			// Return to new entry form
@@ -1203,6 +1462,10 @@ func (m Model) handleProjectSelectKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 			}
 
 			if item, ok := selected.(projectItem); ok {
+				if m.editingEntry != nil {
+					m.selectProjectForEdit(item.project)
+					return m, nil
+				}
 				m.selectedProject = &item.project
 
 				// Find tasks for this project
@@ -1218,6 +1481,7 @@ func (m Model) handleProjectSelectKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 						if len(pwt.Tasks) == 1 {
 							// Only one task, skip task selection
 							m.selectedTask = &pwt.Tasks[0]
+							m.newEntryBillable = pwt.Tasks[0].Billable
 							// Initialize notes input
 							notesInput := textinput.New()
 							notesInput.Focus()
@@ -1270,14 +1534,18 @@ func (m Model) handleTaskSelectKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 		if selected != nil {
 			if item, ok := selected.(taskItem); ok {
 				if m.editingEntry != nil {
-					// Set editTask and return to edit view
-					m.editTask = &harvest.Task{ID: item.task.ID, Name: item.task.Name}
-					m.selectedProject = nil
-					m.currentView = ViewEditEntry
-					m.updateEditFieldFocus()
+					// A project picked from project selection only applies along with its task
+					project := harvest.Project{ID: m.editProjectID()}
+					if m.selectedProject != nil {
+						project = *m.selectedProject
+					} else if m.editProject != nil {
+						project = *m.editProject
+					}
+					m.applyEditSelection(project, item.task)
 					return m, nil
 				}
 				m.selectedTask = &item.task
+				m.newEntryBillable = item.task.Billable
 				// Initialize notes input
 				notesInput := textinput.New()
 				notesInput.Focus()
@@ -1307,44 +1575,60 @@ func (m Model) handleEditViewKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 
 	case "tab":
 		// Move to next field
-		m.editCurrentField = (m.editCurrentField + 1) % 3
+		m.editCurrentField = (m.editCurrentField + 1) % 6
 		m.updateEditFieldFocus()
 		return m, nil
 
 	case "shift+tab":
 		// Move to previous field
-		m.editCurrentField = (m.editCurrentField - 1 + 3) % 3
+		m.editCurrentField = (m.editCurrentField - 1 + 6) % 6
 		m.updateEditFieldFocus()
 		return m, nil
 
 	case "enter":
-		if m.editCurrentField == 0 {
-			// Open task selection for the current project
-			if m.editingEntry != nil {
-				if len(m.projectsWithTasks) == 0 {
-					m.pendingTaskEdit = true
+		if m.editCurrentField == 5 {
+			m.editBillable = !m.editBillable
+			return m, nil
+		}
+		if (m.editCurrentField == 0 || m.editCurrentField == 1) && m.editingEntry != nil {
+			if len(m.projectsWithTasks) == 0 {
+				m.pendingTaskEdit = true
+				if m.editCurrentField == 0 {
+					m.setStatusMessage("Loading projects...")
+				} else {
 					m.setStatusMessage("Loading tasks...")
-					return m, fetchProjectsWithTasksCmd(m.harvestClient)
-				}
-				if !m.openTaskSelectionForEdit() {
-					m.setStatusMessage("No tasks found for this project")
 				}
+				return m, fetchProjectsWithTasksCmd(m.harvestClient)
+			}
+			if m.editCurrentField == 0 {
+				m.openProjectSelectionForEdit()
+			} else if !m.openTaskSelectionForEdit() {
+				m.setStatusMessage("No tasks found for this project")
 			}
 		}
 		return m, nil
 
 	case "ctrl+s":
+		// Re-validate the task against the project before Harvest rejects it
+		if !m.editTaskAssigned() {
+			m.setStatusMessage("Select a task for the new project")
+			return m, nil
+		}
 		// Save changes
 		return m, m.updateTimeEntry()
 
 	default:
 		// Pass to the appropriate input field if it's focused
-		if m.editCurrentField == 1 && m.editNotesInput != nil {
+		if m.editCurrentField == 2 {
+			m.moveEditDate(msg.String())
+		} else if m.editCurrentField == 3 && m.editNotesInput != nil {
 			*m.editNotesInput, cmd = m.editNotesInput.Update(msg)
 			m.editNotes = m.editNotesInput.Value()
-		} else if m.editCurrentField == 2 && m.editDurationInput != nil {
+		} else if m.editCurrentField == 4 && m.editDurationInput != nil {
 			*m.editDurationInput, cmd = m.editDurationInput.Update(msg)
 			m.editHours = m.editDurationInput.Value()
+		} else if m.editCurrentField == 5 && msg.String() == " " {
+			m.editBillable = !m.editBillable
 		}
 	}
 
@@ -1354,14 +1638,14 @@ func (m Model) handleEditViewKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 // updateEditFieldFocus updates text input focus based on the current edit field.
 func (m *Model) updateEditFieldFocus() {
 	if m.editNotesInput != nil {
-		if m.editCurrentField == 1 {
+		if m.editCurrentField == 3 {
 			m.editNotesInput.Focus()
 		} else {
 			m.editNotesInput.Blur()
 		}
 	}
 	if m.editDurationInput != nil {
-		if m.editCurrentField == 2 {
+		if m.editCurrentField == 4 {
 			m.editDurationInput.Focus()
 		} else {
 			m.editDurationInput.Blur()
@@ -1369,19 +1653,60 @@ func (m *Model) updateEditFieldFocus() {
 	}
 }
 
-// openTaskSelectionForEdit finds the editing entry's project tasks and switches to task selection.
+// moveEditDate moves the edit form's date by a day (←/→ or h/l) or a week
+// ([/]), or back to today (t).
+func (m *Model) moveEditDate(key string) {
+	switch key {
+	case "left", "h":
+		m.editDate = m.editDate.AddDate(0, 0, -1)
+	case "right", "l":
+		m.editDate = m.editDate.AddDate(0, 0, 1)
+	case "[":
+		m.editDate = m.editDate.AddDate(0, 0, -7)
+	case "]":
+		m.editDate = m.editDate.AddDate(0, 0, 7)
+	case "t":
+		m.editDate = time.Now()
+	}
+}
+
+// editProjectID returns the project the edited entry is being saved to.
+func (m Model) editProjectID() int {
+	if m.editProject != nil {
+		return m.editProject.ID
+	}
+	return m.editingEntry.Project.ID
+}
+
+// blurEditInputs removes focus from the edit form's text inputs before
+// switching to a selection view.
+func (m *Model) blurEditInputs() {
+	if m.editNotesInput != nil {
+		m.editNotesInput.Blur()
+	}
+	if m.editDurationInput != nil {
+		m.editDurationInput.Blur()
+	}
+}
+
+// openProjectSelectionForEdit switches to project selection to move the
+// editing entry to another project.
+func (m *Model) openProjectSelectionForEdit() {
+	m.updateProjectList()
+	m.setListSizes()
+	m.blurEditInputs()
+	m.currentView = ViewSelectProject
+}
+
+// openTaskSelectionForEdit finds the edit project's tasks and switches to task selection.
 // Returns true if the transition succeeded, false if the project was not found.
 func (m *Model) openTaskSelectionForEdit() bool {
+	projectID := m.editProjectID()
 	for _, pwt := range m.projectsWithTasks {
-		if pwt.Project.ID == m.editingEntry.Project.ID {
+		if pwt.Project.ID == projectID {
 			m.selectedProject = &pwt.Project
 			m.updateTaskList(pwt.Tasks)
-			if m.editNotesInput != nil {
-				m.editNotesInput.Blur()
-			}
-			if m.editDurationInput != nil {
-				m.editDurationInput.Blur()
-			}
+			m.blurEditInputs()
 			m.currentView = ViewSelectTask
 			return true
 		}
@@ -1389,6 +1714,61 @@ func (m *Model) openTaskSelectionForEdit() bool {
 	return false
 }
 
+// selectProjectForEdit handles a project picked while editing. The task must
+// belong to the new project, so a task is always chosen along with it: a
+// project's only task is taken directly, otherwise task selection opens.
+func (m *Model) selectProjectForEdit(project harvest.Project) {
+	for _, pwt := range m.projectsWithTasks {
+		if pwt.Project.ID != project.ID {
+			continue
+		}
+		switch len(pwt.Tasks) {
+		case 0:
+			m.setStatusMessage("No tasks available for this project")
+		case 1:
+			m.applyEditSelection(project, pwt.Tasks[0])
+		default:
+			m.selectedProject = &project
+			m.updateTaskList(pwt.Tasks)
+			m.currentView = ViewSelectTask
+		}
+		return
+	}
+}
+
+// applyEditSelection sets the edit form's project and task and returns to the
+// edit view. Billable follows the new task's default when the task changed.
+func (m *Model) applyEditSelection(project harvest.Project, task harvest.Task) {
+	if task.ID != m.editingEntry.Task.ID || project.ID != m.editingEntry.Project.ID {
+		m.editBillable = task.Billable
+	}
+	m.editProject = &project
+	m.editTask = &harvest.Task{ID: task.ID, Name: task.Name, Billable: task.Billable}
+	m.selectedProject = nil
+	m.currentView = ViewEditEntry
+	m.updateEditFieldFocus()
+}
+
+// editTaskAssigned reports whether the edit task is assigned to the edit
+// project. It trusts the selection when projects have not been loaded.
+func (m Model) editTaskAssigned() bool {
+	if len(m.projectsWithTasks) == 0 || m.editTask == nil {
+		return true
+	}
+	projectID := m.editProjectID()
+	for _, pwt := range m.projectsWithTasks {
+		if pwt.Project.ID != projectID {
+			continue
+		}
+		for _, task := range pwt.Tasks {
+			if task.ID == m.editTask.ID {
+				return true
+			}
+		}
+	}
+	return false
+}
+
 func (m Model) handleConfirmDeleteKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 	switch msg.String() {
 	case "esc", "n":
@@ -1435,7 +1815,7 @@ func (m Model) handleNotesInputKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 		// Initialize duration input
 		durationInput := textinput.New()
 		durationInput.Focus()
-		durationInput.Placeholder = "Enter duration (e.g., 1:30)"
+		durationInput.Placeholder = m.durationPlaceholder()
 		durationInput.Width = 20
 		m.durationInput = &durationInput
 		m.currentView = ViewDurationInput
@@ -1461,17 +1841,16 @@ func (m Model) handleDurationInputKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 	case "enter":
 		// Validate and store duration
 		if m.durationInput != nil {
-			duration := m.durationInput.Value()
-			if duration == "" {
-				duration = "0:00"
+			hours := m.durationInput.Value()
+			if hours == "" {
+				hours = "0:00"
 			}
 			// Validate duration format
-			if _, err := parseDuration(duration); err != nil {
-				m.setStatusMessage("Invalid duration format. Use HH:MM (e.g., 1:30)")
+			if _, err := duration.Parse(hours); err != nil {
+				m.setStatusMessage("Invalid duration format. " + duration.FormatHelp)
 				return m, nil
 			}
-			m.newEntryHours = duration
-			m.newEntryBillable = true // Default to billable
+			m.newEntryHours = hours
 			m.currentView = ViewBillableToggle
 		}
 		return m, nil
@@ -1502,9 +1881,20 @@ func (m Model) handleBillableToggleKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 }
 
 // Messages for handling async operations
+
+// timeEntriesFetchedMsg carries the entries for date (YYYY-MM-DD), which is
+// compared against currentDate so out-of-order responses can be discarded.
 type timeEntriesFetchedMsg struct {
-	entries []harvest.TimeEntry
-	err     error
+	date      string
+	entries   []harvest.TimeEntry
+	fetchedAt time.Time // When the request was sent, for later incremental syncs
+	err       error
+}
+
+// ThrottleMsg reports whether Harvest requests are waiting on the client-side
+// rate limit. Send it from the client's throttle handler to drive the indicator.
+type ThrottleMsg struct {
+	Throttled bool
 }
 
 // tickMsg is sent periodically to update running timers
@@ -1540,50 +1930,70 @@ type timeEntryDeletedMsg struct {
 	err     error
 }
 
+// newTimeEntriesFetchContext cancels any in-flight time entries fetch and
+// returns the context for the next one, so a slow response for a previous
+// date cannot land after the user has moved on.
+func (m *Model) newTimeEntriesFetchContext() context.Context {
+	if m.cancelFetch != nil {
+		m.cancelFetch()
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	m.cancelFetch = cancel
+	return ctx
+}
+
+// newWeekFetchContext cancels any in-flight week fetch and returns the context for the next one.
+func (m *Model) newWeekFetchContext() context.Context {
+	if m.cancelWeekFetch != nil {
+		m.cancelWeekFetch()
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	m.cancelWeekFetch = cancel
+	return ctx
+}
+
 // Commands for fetching data
-func fetchTimeEntriesCmd(client *harvest.Client, date time.Time) tea.Cmd {
+func fetchTimeEntriesCmd(ctx context.Context, client harvest.API, date time.Time) tea.Cmd {
 	return func() tea.Msg {
 		dateStr := date.Format("2006-01-02")
-		entries, err := client.FetchTimeEntries(dateStr)
-		return timeEntriesFetchedMsg{entries: entries, err: err}
+		fetchedAt := time.Now()
+		entries, err := client.FetchTimeEntriesContext(ctx, dateStr)
+		return timeEntriesFetchedMsg{date: dateStr, entries: entries, fetchedAt: fetchedAt, err: err}
 	}
 }
 
-func fetchProjectsWithTasksCmd(client *harvest.Client) tea.Cmd {
+func fetchProjectsWithTasksCmd(client harvest.API) tea.Cmd {
 	return func() tea.Msg {
-		// Fetch projects and task assignments, then aggregate them
-		projects, err := client.FetchProjects()
-		if err != nil {
-			return projectsWithTasksFetchedMsg{err: err}
-		}
-
-		taskAssignments, err := client.FetchTaskAssignments()
+		// The user's own project assignments work for every role, while
+		// /v2/projects and /v2/task_assignments need admin or manager access
+		assignments, err := client.FetchProjectAssignmentsContext(context.Background())
 		if err != nil {
 			return projectsWithTasksFetchedMsg{err: err}
 		}
 
+		projects, taskAssignments := harvest.SplitProjectAssignments(assignments)
 		projectsWithTasks := harvest.AggregateProjectsWithTasks(projects, taskAssignments)
 		return projectsWithTasksFetchedMsg{projectsWithTasks: projectsWithTasks}
 	}
 }
 
-func restartTimeEntryCmd(client *harvest.Client, entryID int) tea.Cmd {
+func restartTimeEntryCmd(client harvest.API, entryID int) tea.Cmd {
 	return func() tea.Msg {
-		entry, err := client.RestartTimeEntry(entryID)
+		entry, err := client.RestartTimeEntryContext(context.Background(), entryID)
 		return timeEntryStartedMsg{entry: entry, err: err}
 	}
 }
 
-func stopTimeEntryCmd(client *harvest.Client, entryID int) tea.Cmd {
+func stopTimeEntryCmd(client harvest.API, entryID int) tea.Cmd {
 	return func() tea.Msg {
-		entry, err := client.StopTimeEntry(entryID)
+		entry, err := client.StopTimeEntryContext(context.Background(), entryID)
 		return timeEntryStoppedMsg{entry: entry, err: err}
 	}
 }
 
-func deleteTimeEntryCmd(client *harvest.Client, entryID int) tea.Cmd {
+func deleteTimeEntryCmd(client harvest.API, entryID int) tea.Cmd {
 	return func() tea.Msg {
-		err := client.DeleteTimeEntry(entryID)
+		err := client.DeleteTimeEntryContext(context.Background(), entryID)
 		return timeEntryDeletedMsg{entryID: entryID, err: err}
 	}
 }
@@ -1595,6 +2005,16 @@ func tickCmd() tea.Cmd {
 	})
 }
 
+// startTicking starts the tick loop unless it is already running, so each
+// running timer or status message does not add a loop of its own.
+func (m *Model) startTicking() tea.Cmd {
+	if m.ticking {
+		return nil
+	}
+	m.ticking = true
+	return tickCmd()
+}
+
 // setStatusMessage sets a status message with a timestamp
 func (m *Model) setStatusMessage(msg string) {
 	m.statusMessage = msg
@@ -1607,6 +2027,28 @@ func (m *Model) clearStatusMessage() {
 	m.statusMessageTime = time.Time{}
 }
 
+// describeError returns a user-facing description of err. Harvest API errors
+// are reduced to the human message from the response body when there is one.
+func describeError(err error) string {
+	var apiErr *harvest.APIError
+	if !errors.As(err, &apiErr) {
+		return err.Error()
+	}
+	switch {
+	case apiErr.IsRateLimited() && apiErr.RetryAfter > 0:
+		return fmt.Sprintf("rate limited by Harvest, retry in %ds", int(apiErr.RetryAfter.Seconds()))
+	case apiErr.IsRateLimited():
+		return "rate limited by Harvest, try again shortly"
+	case apiErr.Message != "":
+		return apiErr.Message
+	case apiErr.IsUnauthorized():
+		return "Harvest rejected the request, check your access token and permissions"
+	case apiErr.IsNotFound():
+		return "entry no longer exists in Harvest"
+	}
+	return err.Error()
+}
+
 // renderStatusLine returns the status message styled based on its content.
 // Success messages render green, errors red, warnings yellow.
 func (m Model) renderStatusLine() string {
@@ -1630,6 +2072,9 @@ func (m Model) renderStatusLine() string {
 
 // hasRunningTimer checks if any time entry has a running timer
 func (m Model) hasRunningTimer() bool {
+	if m.runningEntry != nil {
+		return true
+	}
 	for _, entry := range m.timeEntries {
 		if entry.IsRunning {
 			return true
@@ -1644,22 +2089,34 @@ func (m Model) createTimeEntry() tea.Cmd {
 		return nil
 	}
 
-	// Parse duration
-	hours, err := parseDuration(m.newEntryHours)
-	if err != nil {
-		return nil
+	// Parse duration; zero hours are omitted so Harvest starts a timer
+	var hours float64
+	if !startsTimer(m.newEntryHours) {
+		var err error
+		hours, err = duration.Parse(m.newEntryHours)
+		if err != nil {
+			return nil
+		}
 	}
 
+	billable := m.newEntryBillable
 	request := harvest.CreateTimeEntryRequest{
-		ProjectID: m.selectedProject.ID,
-		TaskID:    m.selectedTask.ID,
-		SpentDate: m.currentDate.Format("2006-01-02"),
-		Hours:     hours,
-		Notes:     m.newEntryNotes,
+		ProjectID:  m.selectedProject.ID,
+		TaskID:     m.selectedTask.ID,
+		SpentDate:  m.currentDate.Format("2006-01-02"),
+		Hours:      hours,
+		Notes:      m.newEntryNotes,
+		IsBillable: &billable,
+	}
+
+	// A "09:15–10:45" range also sets the times for timestamp-based accounts
+	if r, ok := duration.ParseRange(m.newEntryHours); ok {
+		request.StartedTime = duration.FormatClock(r.Start)
+		request.EndedTime = duration.FormatClock(r.End)
 	}
 
 	return func() tea.Msg {
-		entry, err := m.harvestClient.CreateTimeEntry(request)
+		entry, err := m.harvestClient.CreateTimeEntryContext(context.Background(), request)
 		if err != nil {
 			return timeEntryCreatedMsg{err: err}
 		}
@@ -1686,11 +2143,12 @@ func (m Model) updateTimeEntry() tea.Cmd {
 	}
 
 	// Validate duration
-	hours, err := parseDuration(m.editHours)
+	// A leading sign adjusts the entry's saved hours, e.g. "-0:15"
+	hours, err := duration.ParseRelative(m.editHours, m.editingEntry.Hours)
 	if err != nil {
 		// Return an error message
 		return func() tea.Msg {
-			return timeEntryUpdatedMsg{err: fmt.Errorf("Invalid duration format. Use HH:MM (e.g., 1:30)")}
+			return timeEntryUpdatedMsg{err: err}
 		}
 	}
 
@@ -1699,15 +2157,40 @@ func (m Model) updateTimeEntry() tea.Cmd {
 		Notes: &m.editNotes,
 	}
 
+	// A "09:15–10:45" range also sets the times for timestamp-based accounts
+	if r, ok := duration.ParseRange(m.editHours); ok {
+		started, ended := duration.FormatClock(r.Start), duration.FormatClock(r.End)
+		request.StartedTime = &started
+		request.EndedTime = &ended
+	}
+
+	// Include ProjectID if the project was changed
+	if projectID := m.editProjectID(); projectID != m.editingEntry.Project.ID {
+		request.ProjectID = &projectID
+	}
+
 	// Include TaskID if the task was changed
 	if m.editTask != nil && m.editTask.ID != m.editingEntry.Task.ID {
 		request.TaskID = &m.editTask.ID
 	}
 
+	// Include SpentDate if the entry was moved to another day
+	if !m.editDate.IsZero() {
+		if spentDate := m.editDate.Format("2006-01-02"); spentDate != m.editingEntry.SpentDate {
+			request.SpentDate = &spentDate
+		}
+	}
+
+	// Include billable status if it was toggled
+	if m.editBillable != m.editingEntry.IsBillable {
+		billable := m.editBillable
+		request.IsBillable = &billable
+	}
+
 	entryID := m.editingEntry.ID
 
 	return func() tea.Msg {
-		entry, err := m.harvestClient.UpdateTimeEntry(entryID, request)
+		entry, err := m.harvestClient.UpdateTimeEntryContext(context.Background(), entryID, request)
 		if err != nil {
 			return timeEntryUpdatedMsg{err: err}
 		}
@@ -1761,6 +2244,15 @@ func (m Model) renderNewEntryModal() string {
 	} else {
 		durationView = m.newEntryHours
 	}
+	durationValue := m.newEntryHours
+	if m.durationInput != nil {
+		durationValue = m.durationInput.Value()
+	}
+	if startsTimer(durationValue) {
+		durationView += "  " + MutedText.Render("▶ starts a timer")
+	} else if preview := m.renderDurationPreview(durationValue, -1); preview != "" {
+		durationView += "  " + preview
+	}
 
 	// Status message
 	statusLine := m.renderStatusLine()
@@ -1777,6 +2269,8 @@ func (m Model) renderNewEntryModal() string {
 		"  " + fieldLabel("Notes:", m.newEntryCurrentField == 2) + " " + notesView,
 		"",
 		"  " + fieldLabel("Duration:", m.newEntryCurrentField == 3) + " " + durationView,
+		"",
+		"  " + fieldLabel("Billable:", m.newEntryCurrentField == 4) + " " + renderBillableCheckbox(m.newEntryBillable),
 	}
 	if statusLine != "" {
 		contentLines = append(contentLines, "", statusLine)
@@ -1790,6 +2284,9 @@ func (m Model) renderNewEntryModal() string {
 	if m.newEntryCurrentField <= 1 {
 		footerKeys = append(footerKeys, RenderKeybinding("enter", "select"))
 	}
+	if m.newEntryCurrentField == 4 {
+		footerKeys = append(footerKeys, RenderKeybinding("space", "toggle"))
+	}
 	footerKeys = append(footerKeys,
 		RenderKeybinding("ctrl+s", "save"),
 		RenderKeybinding("esc", "cancel"),
@@ -1811,7 +2308,7 @@ func (m Model) handleNewEntryKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 
 	case "tab":
 		// Move to next field
-		m.newEntryCurrentField = (m.newEntryCurrentField + 1) % 4
+		m.newEntryCurrentField = (m.newEntryCurrentField + 1) % 5
 		// Update focus for text inputs
 		if m.newEntryCurrentField == 2 && m.notesInput != nil {
 			m.notesInput.Focus()
@@ -1836,7 +2333,7 @@ func (m Model) handleNewEntryKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 
 	case "shift+tab":
 		// Move to previous field
-		m.newEntryCurrentField = (m.newEntryCurrentField - 1 + 4) % 4
+		m.newEntryCurrentField = (m.newEntryCurrentField - 1 + 5) % 5
 		// Update focus for text inputs
 		if m.newEntryCurrentField == 2 && m.notesInput != nil {
 			m.notesInput.Focus()
@@ -1881,6 +2378,9 @@ func (m Model) handleNewEntryKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 				}
 			}
 			return m, nil
+		case 4: // Billable field
+			m.newEntryBillable = !m.newEntryBillable
+			return m, nil
 		}
 		return m, nil
 
@@ -1900,10 +2400,12 @@ func (m Model) handleNewEntryKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 			m.newEntryHours = m.durationInput.Value()
 		}
 
-		// Validate duration
-		if _, err := parseDuration(m.newEntryHours); err != nil {
-			m.setStatusMessage("Invalid duration format. Use HH:MM (e.g., 1:30)")
-			return m, nil
+		// Validate duration; empty or 0:00 starts a timer
+		if !startsTimer(m.newEntryHours) {
+			if _, err := duration.Parse(m.newEntryHours); err != nil {
+				m.setStatusMessage("Invalid duration format. " + duration.FormatHelp)
+				return m, nil
+			}
 		}
 
 		return m, m.createTimeEntry()
@@ -1916,6 +2418,8 @@ func (m Model) handleNewEntryKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 		} else if m.newEntryCurrentField == 3 && m.durationInput != nil {
 			*m.durationInput, cmd = m.durationInput.Update(msg)
 			m.newEntryHours = m.durationInput.Value()
+		} else if m.newEntryCurrentField == 4 && msg.String() == " " {
+			m.newEntryBillable = !m.newEntryBillable
 		}
 	}
 
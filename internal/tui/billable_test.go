@@ -0,0 +1,184 @@
+package tui
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/planetargon/harvest-tui/internal/harvest"
+	"github.com/planetargon/harvest-tui/internal/harvest/harvestfake"
+)
+
+// recordingAPI records the last update request sent through a fake client.
+type recordingAPI struct {
+	*harvestfake.Client
+	lastUpdate harvest.UpdateTimeEntryRequest
+}
+
+func (r *recordingAPI) UpdateTimeEntryContext(ctx context.Context, id int, request harvest.UpdateTimeEntryRequest) (*harvest.TimeEntry, error) {
+	r.lastUpdate = request
+	return r.Client.UpdateTimeEntryContext(ctx, id, request)
+}
+
+func TestBillableToggle(t *testing.T) {
+	t.Run("given non-billable task when selected for new entry then billable defaults off", func(t *testing.T) {
+		model := newTestModel()
+		model.currentView = ViewSelectTask
+		model.selectedProject = &harvest.Project{ID: 30, Name: "Internal"}
+		model.newEntryBillable = true
+		model.updateTaskList([]harvest.Task{{ID: 102, Name: "Meetings", Billable: false}})
+
+		newModel, _ := model.Update(tea.KeyMsg{Type: tea.KeyEnter})
+		m := newModel.(Model)
+
+		if m.newEntryBillable {
+			t.Error("expected newEntryBillable to default to false from the task assignment")
+		}
+	})
+
+	t.Run("given non-billable toggle when entry created then sends billable false", func(t *testing.T) {
+		m, fake := newFakeModel(t)
+		m.selectedProject = &harvest.Project{ID: 10, Name: "Website", Client: harvest.ProjectClient{ID: 1, Name: "Acme"}}
+		m.selectedTask = &harvest.Task{ID: 100, Name: "Development", Billable: true}
+		m.newEntryHours = "1:00"
+		m.newEntryBillable = true
+		m.currentView = ViewBillableToggle
+
+		newModel, _ := m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune(" ")})
+		m = newModel.(Model)
+		_, cmd := m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+		runCmd(t, m, cmd)
+
+		entries := fake.TimeEntries()
+		if len(entries) != 1 || entries[0].IsBillable {
+			t.Errorf("expected a non-billable entry, got %+v", entries)
+		}
+	})
+
+	t.Run("given project with one non-billable task when selected and entry created then sends billable false", func(t *testing.T) {
+		m, fake := newFakeModel(t)
+		internal := harvest.Project{ID: 30, Name: "Internal", Client: harvest.ProjectClient{ID: 3, Name: "Planet Argon"}}
+		meetings := harvest.Task{ID: 102, Name: "Meetings", Billable: false}
+		fake.AddProject(internal, meetings)
+		m.projectsWithTasks = []harvest.ProjectWithTasks{{Project: internal, Tasks: []harvest.Task{meetings}}}
+		m.newEntryBillable = true
+		m.newEntryHours = "1:00"
+		m.currentView = ViewSelectProject
+		m.updateProjectList()
+		m.projectList.Select(0)
+
+		newModel, _ := m.handleProjectSelectKeys(tea.KeyMsg{Type: tea.KeyEnter})
+		m = newModel.(Model)
+		if m.currentView != ViewNotesInput || m.selectedTask == nil || m.selectedTask.ID != 102 {
+			t.Fatalf("expected the single task picked and notes input shown, got %v", m.currentView)
+		}
+		m.currentView = ViewBillableToggle
+		_, cmd := m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+		runCmd(t, m, cmd)
+
+		entries := fake.TimeEntries()
+		if len(entries) != 1 || entries[0].IsBillable {
+			t.Errorf("expected a non-billable entry, got %+v", entries)
+		}
+	})
+
+	t.Run("given new entry form on billable field when space pressed then toggles billable", func(t *testing.T) {
+		model := newTestModel()
+		model.currentView = ViewNewEntry
+		model.newEntryCurrentField = 4
+		model.newEntryBillable = true
+
+		newModel, _ := model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune(" ")})
+		m := newModel.(Model)
+
+		if m.newEntryBillable {
+			t.Error("expected billable to be toggled off")
+		}
+		if !strings.Contains(m.View(), "[ ] Non-billable") {
+			t.Error("expected form to show non-billable checkbox")
+		}
+	})
+
+	t.Run("given edit form on billable field when toggled and saved then updates billable in Harvest", func(t *testing.T) {
+		m, fake := newFakeModel(t)
+		entry := fake.AddTimeEntry(harvest.TimeEntry{
+			SpentDate:  "2025-01-15",
+			Hours:      1,
+			IsBillable: true,
+			Project:    harvest.TimeEntryProject{ID: 10, Name: "Website"},
+			Task:       harvest.TimeEntryTask{ID: 100, Name: "Development"},
+		})
+		m.timeEntries = []harvest.TimeEntry{entry}
+
+		newModel, _ := m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("e")})
+		m = newModel.(Model)
+		m.editCurrentField = 5
+		m.updateEditFieldFocus()
+
+		newModel, _ = m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+		m = newModel.(Model)
+		if m.editBillable {
+			t.Fatal("expected editBillable to be toggled off")
+		}
+		if !strings.Contains(m.View(), "[ ] Non-billable") {
+			t.Error("expected edit form to show non-billable checkbox")
+		}
+
+		_, cmd := m.Update(tea.KeyMsg{Type: tea.KeyCtrlS})
+		m = runCmd(t, m, cmd)
+
+		if fake.TimeEntries()[0].IsBillable {
+			t.Error("expected entry to be non-billable in Harvest")
+		}
+		if m.timeEntries[0].IsBillable {
+			t.Error("expected list entry to be non-billable")
+		}
+	})
+
+	t.Run("given unchanged billable when entry updated then billable is not sent", func(t *testing.T) {
+		model, fake := newFakeModel(t)
+		entry := fake.AddTimeEntry(harvest.TimeEntry{SpentDate: "2025-01-15", Hours: 1, IsBillable: true})
+		recorder := &recordingAPI{Client: fake}
+		model.harvestClient = recorder
+		model.editingEntry = &entry
+		model.editTask = &harvest.Task{ID: entry.Task.ID}
+		model.editBillable = true
+		model.editHours = "2:00"
+
+		model.updateTimeEntry()()
+
+		if recorder.lastUpdate.IsBillable != nil {
+			t.Errorf("expected billable to be omitted, got %v", *recorder.lastUpdate.IsBillable)
+		}
+	})
+
+	t.Run("given edit form when task changed to non-billable task then billable follows the task", func(t *testing.T) {
+		model := newTestModel()
+		model.currentView = ViewSelectTask
+		model.editingEntry = &harvest.TimeEntry{ID: 1, IsBillable: true, Task: harvest.TimeEntryTask{ID: 100}}
+		model.editBillable = true
+		model.updateTaskList([]harvest.Task{{ID: 102, Name: "Admin", Billable: false}})
+
+		newModel, _ := model.Update(tea.KeyMsg{Type: tea.KeyEnter})
+		m := newModel.(Model)
+
+		if m.editBillable {
+			t.Error("expected editBillable to follow the new task's default")
+		}
+	})
+
+	t.Run("given billable and non-billable entries when rendered then each shows a billing marker", func(t *testing.T) {
+		model := newTestModel()
+
+		billable := model.renderStyledTimeEntry(harvest.TimeEntry{ID: 1, Hours: 1, IsBillable: true}, false, 60)
+		nonBillable := model.renderStyledTimeEntry(harvest.TimeEntry{ID: 2, Hours: 1}, false, 60)
+
+		if !strings.Contains(billable, "$") || !strings.Contains(nonBillable, "$") {
+			t.Error("expected both entries to show a billing marker")
+		}
+		if billable == nonBillable && BillableIcon.Render("$") != NonBillableIcon.Render("$") {
+			t.Error("expected billable and non-billable entries to render differently")
+		}
+	})
+}
@@ -0,0 +1,90 @@
+package tui
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/planetargon/harvest-tui/internal/duration"
+	"github.com/planetargon/harvest-tui/internal/harvest"
+)
+
+// WithCompany returns a copy of the model that formats hours, times, dates and
+// weeks following the account's company settings. Without it the model uses
+// H:MM hours, a 12-hour clock, US dates and Monday-based weeks.
+func (m Model) WithCompany(company *harvest.Company) Model {
+	if company != nil {
+		m.company = *company
+	}
+	return m
+}
+
+// formatHours formats hours as decimal ("1.50") or H:MM ("1:30") following the
+// account's time format.
+func (m Model) formatHours(hours float64) string {
+	if m.company.TimeFormat == "decimal" {
+		return fmt.Sprintf("%.2f", hours)
+	}
+	return formatHoursSimple(hours)
+}
+
+// durationPlaceholder returns the new entry duration hint, suggesting a start
+// and end time on accounts that track time that way.
+func (m Model) durationPlaceholder() string {
+	if m.company.WantsTimestampTimers {
+		if m.company.Clock == "24h" {
+			return "09:15-10:45, or empty to start a timer"
+		}
+		return "9:15am-10:45am, or empty to start a timer"
+	}
+	return "1:30, or empty to start a timer"
+}
+
+// formatClockTime formats minutes since midnight on the account's clock,
+// "2:30pm" for 12-hour accounts or "14:30" for 24-hour ones.
+func (m Model) formatClockTime(minutes int) string {
+	if m.company.Clock == "24h" {
+		return fmt.Sprintf("%02d:%02d", minutes/60%24, minutes%60)
+	}
+	return duration.FormatClock(minutes)
+}
+
+// formatTimeRange formats a start and end time on the account's clock.
+func (m Model) formatTimeRange(r duration.Range) string {
+	return m.formatClockTime(r.Start) + "–" + m.formatClockTime(r.End)
+}
+
+// titleDateLayout returns the layout for full dates such as the title bar's,
+// ordered like the account's date format.
+func (m Model) titleDateLayout() string {
+	switch m.company.DateFormat {
+	case "%d/%m/%Y", "%d.%m.%Y", "%d-%m-%Y":
+		return "Mon, 2 Jan 2006"
+	case "%Y-%m-%d", "%Y.%m.%d", "%Y/%m/%d":
+		return "Mon, 2006-01-02"
+	default:
+		return "Mon, Jan 2, 2006"
+	}
+}
+
+// shortDateLayout returns the layout for day-and-month dates such as the week
+// view's range, ordered like the account's date format.
+func (m Model) shortDateLayout() string {
+	switch m.company.DateFormat {
+	case "%d/%m/%Y", "%d.%m.%Y", "%d-%m-%Y":
+		return "2 Jan"
+	default:
+		return "Jan 2"
+	}
+}
+
+// weekStartDay returns the first day of the week for the account.
+func (m Model) weekStartDay() time.Weekday {
+	switch m.company.WeekStartDay {
+	case "Saturday":
+		return time.Saturday
+	case "Sunday":
+		return time.Sunday
+	default:
+		return time.Monday
+	}
+}
@@ -0,0 +1,159 @@
+package tui
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/planetargon/harvest-tui/internal/duration"
+	"github.com/planetargon/harvest-tui/internal/harvest"
+)
+
+func TestCompanySettings(t *testing.T) {
+	t.Run("given no company settings when formatting then uses H:MM, 12-hour clock and US dates", func(t *testing.T) {
+		m := newTestModel()
+
+		if got := m.formatHours(1.5); got != "1:30" {
+			t.Errorf("expected 1:30, got %s", got)
+		}
+		if got := m.formatTimeRange(duration.Range{Start: 9*60 + 15, End: 14*60 + 30}); got != "9:15am–2:30pm" {
+			t.Errorf("expected 9:15am–2:30pm, got %s", got)
+		}
+		if got := m.weekStartDay(); got != time.Monday {
+			t.Errorf("expected weeks to start on Monday, got %v", got)
+		}
+	})
+
+	t.Run("given nil company when applied then keeps defaults", func(t *testing.T) {
+		m := newTestModel().WithCompany(nil)
+
+		if got := m.formatHours(0.25); got != "0:15" {
+			t.Errorf("expected 0:15, got %s", got)
+		}
+	})
+
+	t.Run("given decimal 24-hour company when formatting then follows the account", func(t *testing.T) {
+		m := newTestModel().WithCompany(&harvest.Company{TimeFormat: "decimal", Clock: "24h"})
+
+		if got := m.formatHours(1.5); got != "1.50" {
+			t.Errorf("expected 1.50, got %s", got)
+		}
+		if got := m.formatTimeRange(duration.Range{Start: 9*60 + 15, End: 14*60 + 30}); got != "09:15–14:30" {
+			t.Errorf("expected 09:15–14:30, got %s", got)
+		}
+	})
+
+	t.Run("given decimal company when list rendered then shows decimal durations and total", func(t *testing.T) {
+		m := newTestModel().WithCompany(&harvest.Company{TimeFormat: "decimal"})
+		m.currentView = ViewList
+		m.timeEntries = []harvest.TimeEntry{
+			{ID: 1, Hours: 1.25, Project: harvest.TimeEntryProject{Name: "Website"}},
+			{ID: 2, Hours: 0.5, Project: harvest.TimeEntryProject{Name: "Support"}},
+		}
+
+		view := m.View()
+		if !strings.Contains(view, "1.25") || !strings.Contains(view, "1.75") {
+			t.Error("expected decimal entry duration and daily total")
+		}
+		if strings.Contains(view, "1:15") {
+			t.Error("expected no H:MM durations")
+		}
+	})
+
+	t.Run("given day-first date format when title rendered then shows day before month", func(t *testing.T) {
+		m := newTestModel().WithCompany(&harvest.Company{DateFormat: "%d/%m/%Y"})
+		m.currentDate = time.Date(2025, 1, 15, 0, 0, 0, 0, time.UTC)
+
+		if !strings.Contains(m.renderTitleBar(), "Wed, 15 Jan 2025") {
+			t.Error("expected day-first title date")
+		}
+	})
+
+	t.Run("given ISO date format when title rendered then shows year first", func(t *testing.T) {
+		m := newTestModel().WithCompany(&harvest.Company{DateFormat: "%Y-%m-%d"})
+		m.currentDate = time.Date(2025, 1, 15, 0, 0, 0, 0, time.UTC)
+
+		if !strings.Contains(m.renderTitleBar(), "Wed, 2025-01-15") {
+			t.Error("expected ISO title date")
+		}
+	})
+
+	t.Run("given day-first date format when entry edited then shows the date day first", func(t *testing.T) {
+		m, fake := newFakeModel(t)
+		m = m.WithCompany(&harvest.Company{DateFormat: "%d/%m/%Y"})
+		m.currentDate = time.Date(2025, 1, 15, 0, 0, 0, 0, time.UTC)
+		m.timeEntries = []harvest.TimeEntry{fake.AddTimeEntry(harvest.TimeEntry{
+			SpentDate: "2025-01-15",
+			Hours:     1,
+			Project:   harvest.TimeEntryProject{ID: 10, Name: "Website"},
+			Task:      harvest.TimeEntryTask{ID: 100, Name: "Development"},
+		})}
+
+		newModel, _ := m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("e")})
+
+		var dateLine string
+		for _, line := range strings.Split(newModel.(Model).View(), "\n") {
+			if strings.Contains(line, "Date:") {
+				dateLine = line
+			}
+		}
+		if !strings.Contains(dateLine, "Wed, 15 Jan 2025") {
+			t.Errorf("expected day-first edit date, got %q", dateLine)
+		}
+	})
+
+	t.Run("given decimal company when entry edited then the form and preview show decimal hours", func(t *testing.T) {
+		m, fake := newFakeModel(t)
+		m = m.WithCompany(&harvest.Company{TimeFormat: "decimal"})
+		m.currentDate = time.Date(2025, 1, 15, 0, 0, 0, 0, time.UTC)
+		m.timeEntries = []harvest.TimeEntry{fake.AddTimeEntry(harvest.TimeEntry{
+			SpentDate: "2025-01-15",
+			Hours:     1.5,
+			Project:   harvest.TimeEntryProject{ID: 10, Name: "Website"},
+			Task:      harvest.TimeEntryTask{ID: 100, Name: "Development"},
+		})}
+
+		m, _ = pressKey(m, "e")
+
+		if m.editHours != "1.50" {
+			t.Errorf("expected decimal edit hours, got %q", m.editHours)
+		}
+		if got := m.renderDurationPreview("+0:15", m.editingEntry.Hours); !strings.Contains(got, "= 1.75, was 1.50") {
+			t.Errorf("expected decimal preview, got %q", got)
+		}
+	})
+
+	t.Run("given day-first date format when entry moved to another day then status shows day first", func(t *testing.T) {
+		m := newTestModel().WithCompany(&harvest.Company{DateFormat: "%d/%m/%Y"})
+		m.currentDate = time.Date(2025, 1, 15, 0, 0, 0, 0, time.UTC)
+
+		newModel, _ := m.Update(timeEntryUpdatedMsg{entry: &harvest.TimeEntry{ID: 1, SpentDate: "2025-01-16"}})
+
+		if got := newModel.(Model).statusMessage; got != "Time entry moved to Thu, 16 Jan" {
+			t.Errorf("expected day-first moved date, got %q", got)
+		}
+	})
+
+	t.Run("given sunday-start company when week view opened then week starts on sunday", func(t *testing.T) {
+		m := newTestModel().WithCompany(&harvest.Company{WeekStartDay: "Sunday"})
+		m.currentDate = time.Date(2025, 1, 15, 0, 0, 0, 0, time.UTC)
+
+		m, _ = m.openWeekView()
+
+		if got := m.weekStart.Format("2006-01-02"); got != "2025-01-12" {
+			t.Errorf("expected week to start 2025-01-12, got %s", got)
+		}
+		if m.weekColIndex != 3 {
+			t.Errorf("expected wednesday in column 3, got %d", m.weekColIndex)
+		}
+	})
+
+	t.Run("given timestamp company when new entry started then duration hint suggests a time range", func(t *testing.T) {
+		m := newTestModel().WithCompany(&harvest.Company{WantsTimestampTimers: true})
+
+		if got := m.durationPlaceholder(); !strings.HasPrefix(got, "9:15am-10:45am") {
+			t.Errorf("expected time range hint, got %q", got)
+		}
+	})
+}
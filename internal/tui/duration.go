@@ -0,0 +1,69 @@
+package tui
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/planetargon/harvest-tui/internal/duration"
+	"github.com/planetargon/harvest-tui/internal/harvest"
+)
+
+// entryTimeRange returns the start and end times of a stopped entry on an
+// account that tracks them.
+func entryTimeRange(entry harvest.TimeEntry) (duration.Range, bool) {
+	start, ok := duration.ParseClock(entry.StartedTime)
+	if !ok {
+		return duration.Range{}, false
+	}
+	end, ok := duration.ParseClock(entry.EndedTime)
+	if !ok || end < start {
+		return duration.Range{}, false
+	}
+	return duration.Range{Start: start, End: end}, true
+}
+
+// startsTimer reports whether a new entry's duration means "start a timer now".
+// Empty and zero durations leave hours out of the request so Harvest starts a
+// running timer instead of creating a stopped zero-hour entry.
+func startsTimer(durationStr string) bool {
+	if strings.TrimSpace(durationStr) == "" {
+		return true
+	}
+	hours, err := duration.Parse(durationStr)
+	return err == nil && hours == 0
+}
+
+// renderDurationPreview renders how a duration input will be saved, in the
+// account's time format, shown under the input as the user types. current is
+// the entry's existing hours when relative adjustments are allowed, or
+// negative when they are not.
+func (m Model) renderDurationPreview(durationStr string, current float64) string {
+	if strings.TrimSpace(durationStr) == "" {
+		return ""
+	}
+
+	var hours float64
+	var err error
+	if current >= 0 {
+		hours, err = duration.ParseRelative(durationStr, current)
+	} else {
+		hours, err = duration.Parse(durationStr)
+	}
+	if err != nil {
+		return ErrorText.Render("✗ " + err.Error())
+	}
+
+	if r, ok := duration.ParseRange(durationStr); ok {
+		return MutedText.Render(fmt.Sprintf("= %s (%s)", m.formatHours(hours), m.formatTimeRange(r)))
+	}
+
+	preview := "= " + m.formatHours(hours)
+	// Decimal accounts already see decimal hours, so only H:MM ones get them added
+	if m.company.TimeFormat != "decimal" {
+		preview += fmt.Sprintf(" (%.2fh)", hours)
+	}
+	if duration.IsRelative(durationStr) && current >= 0 {
+		preview += ", was " + m.formatHours(current)
+	}
+	return MutedText.Render(preview)
+}
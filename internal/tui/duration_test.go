@@ -0,0 +1,66 @@
+package tui
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/charmbracelet/bubbles/textinput"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/planetargon/harvest-tui/internal/harvest"
+)
+
+func TestDurationPreview(t *testing.T) {
+	t.Run("given duration input view when typing then previews the parsed value", func(t *testing.T) {
+		m := newTestModel()
+		m.currentView = ViewDurationInput
+		m.selectedProject = &harvest.Project{ID: 1, Name: "Website"}
+		m.selectedTask = &harvest.Task{ID: 1, Name: "Development"}
+		durationInput := textinput.New()
+		durationInput.SetValue("1h30m+15")
+		m.durationInput = &durationInput
+
+		if !strings.Contains(m.View(), "= 1:45 (1.75h)") {
+			t.Error("expected preview of 1:45")
+		}
+	})
+
+	t.Run("given duration input view when input is invalid then previews the error", func(t *testing.T) {
+		m := newTestModel()
+		m.currentView = ViewDurationInput
+		durationInput := textinput.New()
+		durationInput.SetValue("1:75")
+		m.durationInput = &durationInput
+
+		if !strings.Contains(m.View(), "invalid duration format") {
+			t.Error("expected invalid duration preview")
+		}
+	})
+
+	t.Run("given edit form when relative adjustment typed then previews the result and saves it", func(t *testing.T) {
+		m, fake := newFakeModel(t)
+		entry := fake.AddTimeEntry(harvest.TimeEntry{
+			SpentDate: "2025-01-15",
+			Hours:     1.5,
+			Project:   harvest.TimeEntryProject{ID: 10, Name: "Website"},
+			Task:      harvest.TimeEntryTask{ID: 100, Name: "Development"},
+		})
+		m.timeEntries = []harvest.TimeEntry{entry}
+
+		newModel, _ := m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("e")})
+		m = newModel.(Model)
+		m.editCurrentField = 4
+		m.updateEditFieldFocus()
+		m.editDurationInput.SetValue("")
+		newModel, _ = m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("-0:15")})
+		m = newModel.(Model)
+
+		if !strings.Contains(m.View(), "= 1:15 (1.25h), was 1:30") {
+			t.Error("expected relative preview of 1:15")
+		}
+
+		runCmd(t, m, m.updateTimeEntry())
+		if got := fake.TimeEntries()[0].Hours; got != 1.25 {
+			t.Errorf("expected 1.25 hours saved, got %v", got)
+		}
+	})
+}
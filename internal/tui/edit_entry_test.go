@@ -254,7 +254,7 @@ func TestEditEntry(t *testing.T) {
 			Task:    harvest.TimeEntryTask{ID: 10, Name: "Development"},
 		}
 		model.editTask = &harvest.Task{ID: 10, Name: "Development"}
-		model.editCurrentField = 0
+		model.editCurrentField = 1
 		// projectsWithTasks is empty (not yet loaded)
 
 		msg := tea.KeyMsg{Type: tea.KeyEnter}
@@ -281,7 +281,7 @@ func TestEditEntry(t *testing.T) {
 			Task:    harvest.TimeEntryTask{ID: 10, Name: "Development"},
 		}
 		model.editTask = &harvest.Task{ID: 10, Name: "Development"}
-		model.editCurrentField = 0
+		model.editCurrentField = 1
 		model.pendingTaskEdit = true
 
 		notesInput := textinput.New()
@@ -321,7 +321,7 @@ func TestEditEntry(t *testing.T) {
 			Task:    harvest.TimeEntryTask{ID: 10, Name: "Development"},
 		}
 		model.editTask = &harvest.Task{ID: 10, Name: "Development"}
-		model.editCurrentField = 0
+		model.editCurrentField = 1
 		model.projectsWithTasks = []harvest.ProjectWithTasks{
 			{
 				Project: harvest.Project{ID: 1, Name: "Website"},
@@ -350,7 +350,7 @@ func TestEditEntry(t *testing.T) {
 			Task:    harvest.TimeEntryTask{ID: 10, Name: "Development"},
 		}
 		model.editTask = &harvest.Task{ID: 10, Name: "Development"}
-		model.editCurrentField = 0
+		model.editCurrentField = 1
 
 		// Set up task list with items
 		tasks := []harvest.Task{
@@ -452,7 +452,7 @@ func TestEditEntry(t *testing.T) {
 		}
 	})
 
-	t.Run("given edit view when tab cycles through fields then visits all three fields", func(t *testing.T) {
+	t.Run("given edit view when tab cycles through fields then visits all six fields", func(t *testing.T) {
 		model := NewModel(cfg, client, appState, &harvest.User{FirstName: "Test", LastName: "User"})
 		model.currentView = ViewEditEntry
 		model.editingEntry = &harvest.TimeEntry{ID: 1}
@@ -463,26 +463,18 @@ func TestEditEntry(t *testing.T) {
 		durationInput := textinput.New()
 		model.editDurationInput = &durationInput
 
-		// Tab from field 0 (task) to field 1 (notes)
+		// Tab visits project, task, date, notes, duration, billable, then wraps to project
 		msg := tea.KeyMsg{Type: tea.KeyTab}
-		result, _ := model.Update(msg)
-		m := result.(Model)
-		if m.editCurrentField != 1 {
-			t.Errorf("expected field 1 after first tab, got %d", m.editCurrentField)
-		}
-
-		// Tab from field 1 (notes) to field 2 (duration)
-		result, _ = m.Update(msg)
-		m = result.(Model)
-		if m.editCurrentField != 2 {
-			t.Errorf("expected field 2 after second tab, got %d", m.editCurrentField)
+		m := model
+		for _, want := range []int{1, 2, 3, 4, 5, 0} {
+			result, _ := m.Update(msg)
+			m = result.(Model)
+			if m.editCurrentField != want {
+				t.Errorf("expected field %d after tab, got %d", want, m.editCurrentField)
+			}
 		}
-
-		// Tab from field 2 (duration) wraps to field 0 (task)
-		result, _ = m.Update(msg)
-		m = result.(Model)
-		if m.editCurrentField != 0 {
-			t.Errorf("expected field 0 after third tab, got %d", m.editCurrentField)
+		if m.editNotesInput.Focused() || m.editDurationInput.Focused() {
+			t.Error("expected text inputs to be blurred on the project field")
 		}
 	})
 
@@ -516,11 +508,11 @@ func TestEditEntry(t *testing.T) {
 		if m.currentView != ViewEditEntry {
 			t.Fatalf("expected ViewEditEntry after pressing e, got %v", m.currentView)
 		}
-		if m.editCurrentField != 0 {
-			t.Fatalf("expected editCurrentField to be 0 (task), got %d", m.editCurrentField)
+		if m.editCurrentField != 1 {
+			t.Fatalf("expected editCurrentField to be 1 (task), got %d", m.editCurrentField)
 		}
 
-		// Press enter on task field (field 0)
+		// Press enter on task field (field 1)
 		result, _ = m.Update(tea.KeyMsg{Type: tea.KeyEnter})
 		m = result.(Model)
 
@@ -538,7 +530,7 @@ func TestEditEntry(t *testing.T) {
 			Task:    harvest.TimeEntryTask{ID: 10, Name: "Development"},
 		}
 		model.editTask = &harvest.Task{ID: 10, Name: "Development"}
-		model.editCurrentField = 1 // Notes field
+		model.editCurrentField = 3 // Notes field
 		model.editNotes = "Some notes"
 		model.editHours = "1:30"
 
@@ -568,7 +560,7 @@ func TestEditEntry(t *testing.T) {
 			Task:    harvest.TimeEntryTask{ID: 10, Name: "Development"},
 		}
 		model.editTask = &harvest.Task{ID: 10, Name: "Development"}
-		model.editCurrentField = 2 // Duration field
+		model.editCurrentField = 4 // Duration field
 		model.editNotes = "Some notes"
 		model.editHours = "1:30"
 
@@ -598,7 +590,7 @@ func TestEditEntry(t *testing.T) {
 			Task:    harvest.TimeEntryTask{ID: 10, Name: "Development"},
 		}
 		model.editTask = &harvest.Task{ID: 10, Name: "Development"}
-		model.editCurrentField = 1 // Notes field - should save from any field
+		model.editCurrentField = 3 // Notes field - should save from any field
 		model.editNotes = "Updated notes"
 		model.editHours = "2:00"
 
@@ -0,0 +1,309 @@
+package tui
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/charmbracelet/bubbles/textinput"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/planetargon/harvest-tui/internal/export"
+	"github.com/planetargon/harvest-tui/internal/harvest"
+)
+
+// Fields of the export form, in tab order.
+const (
+	exportFieldFrom = iota
+	exportFieldTo
+	exportFieldPath
+	exportFieldCount
+)
+
+// exportForm is the state of the form exporting a date range to CSV.
+type exportForm struct {
+	inputs        [exportFieldCount]textinput.Model
+	field         int
+	suggestedPath string    // Path offered for the range, followed as the dates change until edited
+	exporting     bool      // The export is in flight
+	replacePath   string    // Existing file the user was asked about; enter again replaces it
+	returnView    ViewState // View to go back to once done
+}
+
+// entriesExportedMsg is sent when an export has been written to path, or
+// with exists set when path is already taken and was left alone.
+type entriesExportedMsg struct {
+	path    string
+	entries int
+	hours   float64
+	exists  bool
+	err     error
+}
+
+// exportEntriesCmd fetches the entries from from to to and writes them to
+// path as CSV with the given columns and rounding. An existing file is only
+// replaced when replace is set.
+func exportEntriesCmd(client harvest.API, from, to, path string, columns []string, rounding export.Rounding, replace bool) tea.Cmd {
+	return func() tea.Msg {
+		entries, err := client.FetchTimeEntriesRangeContext(context.Background(), from, to)
+		if err != nil {
+			return entriesExportedMsg{err: err}
+		}
+		report := export.Report(entries, rounding)
+
+		flags := os.O_WRONLY | os.O_CREATE | os.O_EXCL
+		if replace {
+			flags = os.O_WRONLY | os.O_CREATE | os.O_TRUNC
+		}
+		file, err := os.OpenFile(path, flags, 0644)
+		if errors.Is(err, fs.ErrExist) {
+			return entriesExportedMsg{path: path, exists: true}
+		}
+		if err != nil {
+			return entriesExportedMsg{err: err}
+		}
+		if err := export.WriteCSVColumns(file, report, columns); err != nil {
+			file.Close()
+			return entriesExportedMsg{err: err}
+		}
+		if err := file.Close(); err != nil {
+			return entriesExportedMsg{err: err}
+		}
+
+		if abs, err := filepath.Abs(path); err == nil {
+			path = abs
+		}
+		return entriesExportedMsg{path: path, entries: len(report), hours: export.Sum(report).Hours}
+	}
+}
+
+// openExportForm opens the export form for the week shown, or the week of
+// the day shown in the list.
+func (m Model) openExportForm() (Model, tea.Cmd) {
+	weekStart := m.weekStart
+	if m.currentView != ViewWeek || weekStart.IsZero() {
+		weekStart = startOfWeek(m.currentDate, m.weekStartDay())
+	}
+	from := weekStart.Format("2006-01-02")
+	to := weekStart.AddDate(0, 0, daysInWeek-1).Format("2006-01-02")
+
+	form := &exportForm{returnView: m.currentView}
+	for i := range form.inputs {
+		input := textinput.New()
+		input.Width = 50
+		form.inputs[i] = input
+	}
+	form.inputs[exportFieldFrom].SetValue(from)
+	form.inputs[exportFieldFrom].Placeholder = "YYYY-MM-DD"
+	form.inputs[exportFieldTo].SetValue(to)
+	form.inputs[exportFieldTo].Placeholder = "YYYY-MM-DD"
+	form.suggestedPath = m.suggestedExportPath(from, to)
+	form.inputs[exportFieldPath].SetValue(form.suggestedPath)
+	form.inputs[exportFieldPath].Placeholder = "harvest.csv"
+	form.inputs[exportFieldFrom].Focus()
+
+	m.exportForm = form
+	m.currentView = ViewExport
+	m.clearStatusMessage()
+	return m, nil
+}
+
+// suggestedExportPath names the file for a range in the configured export
+// directory.
+func (m Model) suggestedExportPath(from, to string) string {
+	name := fmt.Sprintf("harvest-%s-to-%s.csv", from, to)
+	if m.config == nil || m.config.Export.Directory == "" {
+		return name
+	}
+	return filepath.Join(m.config.Export.Directory, name)
+}
+
+// exportColumns returns the configured CSV columns, or the defaults.
+func (m Model) exportColumns() []string {
+	if m.config == nil || len(m.config.Export.Columns) == 0 {
+		return export.DefaultColumns
+	}
+	return m.config.Export.Columns
+}
+
+// exportRounding returns the configured rounding, none without a config.
+func (m Model) exportRounding() export.Rounding {
+	if m.config == nil {
+		return export.Rounding{}
+	}
+	return m.config.Export.Rounding()
+}
+
+// expandHome replaces a leading "~/" with the home directory.
+func expandHome(path string) string {
+	if !strings.HasPrefix(path, "~/") {
+		return path
+	}
+	homeDir, err := os.UserHomeDir()
+	if err != nil {
+		return path
+	}
+	return filepath.Join(homeDir, path[2:])
+}
+
+func (m Model) handleExportKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	form := m.exportForm
+	if form == nil || form.exporting {
+		return m, nil
+	}
+
+	switch msg.String() {
+	case "esc":
+		m.currentView = form.returnView
+		m.exportForm = nil
+		m.clearStatusMessage()
+		return m, nil
+	case "tab", "down":
+		form.focus((form.field + 1) % exportFieldCount)
+		return m, nil
+	case "shift+tab", "up":
+		form.focus((form.field - 1 + exportFieldCount) % exportFieldCount)
+		return m, nil
+	case "enter":
+		return m.submitExport()
+	}
+
+	var cmd tea.Cmd
+	form.inputs[form.field], cmd = form.inputs[form.field].Update(msg)
+
+	// Keep the suggested file name in step with the dates until it is edited
+	if form.field != exportFieldPath && form.inputs[exportFieldPath].Value() == form.suggestedPath {
+		form.suggestedPath = m.suggestedExportPath(
+			strings.TrimSpace(form.inputs[exportFieldFrom].Value()),
+			strings.TrimSpace(form.inputs[exportFieldTo].Value()),
+		)
+		form.inputs[exportFieldPath].SetValue(form.suggestedPath)
+	}
+	return m, cmd
+}
+
+// focus moves the cursor to the end of field.
+func (f *exportForm) focus(field int) {
+	f.inputs[f.field].Blur()
+	f.field = field
+	f.inputs[f.field].Focus()
+	f.inputs[f.field].CursorEnd()
+}
+
+// submitExport checks the form and starts writing the export.
+func (m Model) submitExport() (Model, tea.Cmd) {
+	form := m.exportForm
+	from := strings.TrimSpace(form.inputs[exportFieldFrom].Value())
+	to := strings.TrimSpace(form.inputs[exportFieldTo].Value())
+	path := strings.TrimSpace(form.inputs[exportFieldPath].Value())
+
+	for _, date := range []string{from, to} {
+		if _, err := time.Parse("2006-01-02", date); err != nil {
+			m.setStatusMessage(fmt.Sprintf("Invalid date %q. Use YYYY-MM-DD", date))
+			return m, nil
+		}
+	}
+	if to < from {
+		m.setStatusMessage("Invalid range: the last day is before the first")
+		return m, nil
+	}
+	if path == "" {
+		m.setStatusMessage("Invalid file: enter where to save the export")
+		return m, nil
+	}
+
+	path = expandHome(path)
+	replace := path == form.replacePath
+	form.replacePath = ""
+	form.exporting = true
+	m.clearStatusMessage()
+	return m, exportEntriesCmd(m.harvestClient, from, to, path, m.exportColumns(), m.exportRounding(), replace)
+}
+
+// applyEntriesExported closes the form once the export is written, or keeps
+// it open to retry when it failed.
+func (m *Model) applyEntriesExported(msg entriesExportedMsg) {
+	form := m.exportForm
+	if form == nil {
+		return
+	}
+	if msg.err != nil {
+		form.exporting = false
+		m.setStatusMessage("Export failed: " + describeError(msg.err))
+		return
+	}
+	if msg.exists {
+		form.exporting = false
+		form.replacePath = msg.path
+		m.setStatusMessage(filepath.Base(msg.path) + " already exists. Press enter again to replace it")
+		return
+	}
+
+	m.currentView = form.returnView
+	m.exportForm = nil
+	m.setStatusMessage(fmt.Sprintf("Exported %d entries (%s) to %s", msg.entries, m.formatHours(msg.hours), msg.path))
+}
+
+func (m Model) renderExportView() string {
+	width := m.shellWidth()
+	form := m.exportForm
+
+	titleBar := m.renderTitleBar()
+
+	breadcrumb := "  " + AccentText.Render("Export to CSV")
+
+	divider := "  " + RenderDividerWidth(width-4)
+
+	labels := [exportFieldCount]string{"From:", "To:", "File:"}
+	contentLines := []string{titleBar, breadcrumb, divider, ""}
+	for i, label := range labels {
+		contentLines = append(contentLines, "  "+fieldLabel(label, form.field == i)+" "+form.inputs[i].View(), "")
+	}
+
+	columns := strings.Join(m.exportColumns(), ", ")
+	contentLines = append(contentLines, "  "+MutedText.Render("Columns: "+columns))
+	if rounding := m.exportRounding(); rounding.Increment > 0 {
+		mode := rounding.Mode
+		if mode == "" {
+			mode = export.RoundNearest
+		}
+		contentLines = append(contentLines, "  "+MutedText.Render(fmt.Sprintf("Hours rounded %s to %s", mode, formatRoundingIncrement(rounding.Increment))))
+	}
+
+	var footerKeys []string
+	if form.exporting {
+		contentLines = append(contentLines, "", "  "+m.spinner.View()+" "+MutedText.Render("Exporting..."))
+	} else {
+		footerKeys = []string{
+			RenderKeybinding("tab", "next field"),
+			RenderKeybinding("enter", "export"),
+			RenderKeybinding("esc", "cancel"),
+		}
+	}
+
+	if statusLine := m.renderStatusLine(); statusLine != "" {
+		contentLines = append(contentLines, "", statusLine)
+	}
+
+	return m.buildShellBox(strings.Join(contentLines, "\n"), width, footerKeys)
+}
+
+// formatRoundingIncrement formats a rounding increment such as "15 minutes"
+// or "1 hour".
+func formatRoundingIncrement(increment time.Duration) string {
+	if increment%time.Hour == 0 {
+		if increment == time.Hour {
+			return "1 hour"
+		}
+		return fmt.Sprintf("%d hours", increment/time.Hour)
+	}
+	minutes := int(increment / time.Minute)
+	if minutes == 1 {
+		return "1 minute"
+	}
+	return fmt.Sprintf("%d minutes", minutes)
+}
@@ -0,0 +1,197 @@
+package tui
+
+import (
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/planetargon/harvest-tui/internal/config"
+	"github.com/planetargon/harvest-tui/internal/harvest"
+)
+
+func pressSpecialKey(m Model, keyType tea.KeyType) (Model, tea.Cmd) {
+	newModel, cmd := m.Update(tea.KeyMsg{Type: keyType})
+	return newModel.(Model), cmd
+}
+
+func TestExportForm(t *testing.T) {
+	t.Run("given list view when x pressed then offers the week's range and a file in the export directory", func(t *testing.T) {
+		m, _ := newFakeModel(t)
+		m.config.Export = config.ExportConfig{Directory: "/tmp/invoices"}
+
+		m, _ = pressKey(m, "x")
+
+		if m.currentView != ViewExport {
+			t.Fatalf("expected ViewExport, got %v", m.currentView)
+		}
+		form := m.exportForm
+		if form.inputs[exportFieldFrom].Value() != "2025-01-13" || form.inputs[exportFieldTo].Value() != "2025-01-19" {
+			t.Errorf("expected the week of Jan 15, got %s to %s",
+				form.inputs[exportFieldFrom].Value(), form.inputs[exportFieldTo].Value())
+		}
+		if got := form.inputs[exportFieldPath].Value(); got != "/tmp/invoices/harvest-2025-01-13-to-2025-01-19.csv" {
+			t.Errorf("unexpected suggested path %q", got)
+		}
+		if !strings.Contains(m.View(), "Columns: date, client, project, task, notes, hours, duration, billable, locked") {
+			t.Error("expected the default columns to be shown")
+		}
+	})
+
+	t.Run("given range typed when dates change then the suggested file follows until edited", func(t *testing.T) {
+		m, _ := newFakeModel(t)
+		m, _ = pressKey(m, "x")
+
+		m, _ = pressSpecialKey(m, tea.KeyBackspace)
+		m, _ = pressKey(m, "0")
+		if got := m.exportForm.inputs[exportFieldPath].Value(); got != "harvest-2025-01-10-to-2025-01-19.csv" {
+			t.Errorf("expected path to follow the date, got %q", got)
+		}
+
+		m, _ = pressSpecialKey(m, tea.KeyShiftTab)
+		m, _ = pressKey(m, "?")
+		if m.currentView != ViewExport || !strings.HasSuffix(m.exportForm.inputs[exportFieldPath].Value(), ".csv?") {
+			t.Fatalf("expected ? typed into the file field, got %q", m.exportForm.inputs[exportFieldPath].Value())
+		}
+		m, _ = pressSpecialKey(m, tea.KeyTab)
+		m, _ = pressSpecialKey(m, tea.KeyBackspace)
+		if got := m.exportForm.inputs[exportFieldPath].Value(); got != "harvest-2025-01-10-to-2025-01-19.csv?" {
+			t.Errorf("expected edited path kept, got %q", got)
+		}
+	})
+
+	t.Run("given range and config when exported then writes the chosen columns rounded and returns", func(t *testing.T) {
+		m, fake := newFakeModel(t)
+		dir := t.TempDir()
+		m.config.Export = config.ExportConfig{
+			Columns:   []string{"date", "notes", "hours"},
+			RoundTo:   15 * time.Minute,
+			RoundMode: "up",
+			Directory: dir,
+		}
+		fake.AddTimeEntry(harvest.TimeEntry{SpentDate: "2025-01-16", Hours: 1.1, Notes: "Review"})
+		fake.AddTimeEntry(harvest.TimeEntry{SpentDate: "2025-01-13", Hours: 2, Notes: "Kickoff"})
+		fake.AddTimeEntry(harvest.TimeEntry{SpentDate: "2025-01-20", Hours: 1, Notes: "Next week"})
+		m, _ = pressKey(m, "x")
+
+		m, cmd := pressSpecialKey(m, tea.KeyEnter)
+		if !strings.Contains(m.View(), "Exporting...") {
+			t.Error("expected progress while exporting")
+		}
+		m = runCmd(t, m, cmd)
+
+		if m.currentView != ViewList || m.exportForm != nil {
+			t.Fatalf("expected the form closed, got %v", m.currentView)
+		}
+		path := filepath.Join(dir, "harvest-2025-01-13-to-2025-01-19.csv")
+		data, err := os.ReadFile(path)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if want := "date,notes,hours\n2025-01-13,Kickoff,2.00\n2025-01-16,Review,1.25\n"; string(data) != want {
+			t.Errorf("expected:\n%s\ngot:\n%s", want, data)
+		}
+		if m.statusMessage != "Exported 2 entries (3:15) to "+path {
+			t.Errorf("unexpected status %q", m.statusMessage)
+		}
+	})
+
+	t.Run("given week view when exported then uses the week shown and returns to it", func(t *testing.T) {
+		m, _ := newFakeModel(t)
+		m.config.Export = config.ExportConfig{Directory: t.TempDir()}
+		m.currentView = ViewWeek
+		m.weekStart = time.Date(2025, 1, 6, 0, 0, 0, 0, time.UTC)
+
+		m, _ = pressKey(m, "x")
+		if m.exportForm.inputs[exportFieldFrom].Value() != "2025-01-06" {
+			t.Errorf("expected the week shown, got %s", m.exportForm.inputs[exportFieldFrom].Value())
+		}
+		m, cmd := pressSpecialKey(m, tea.KeyEnter)
+		m = runCmd(t, m, cmd)
+
+		if m.currentView != ViewWeek {
+			t.Errorf("expected ViewWeek, got %v", m.currentView)
+		}
+	})
+
+	t.Run("given last day before the first when submitted then explains and stays open", func(t *testing.T) {
+		m, _ := newFakeModel(t)
+		m, _ = pressKey(m, "x")
+		m.exportForm.inputs[exportFieldTo].SetValue("2025-01-01")
+
+		m, cmd := pressSpecialKey(m, tea.KeyEnter)
+
+		if cmd != nil || m.currentView != ViewExport {
+			t.Fatal("expected the form to stay open without exporting")
+		}
+		if !strings.Contains(m.statusMessage, "Invalid range") {
+			t.Errorf("expected range error, got %q", m.statusMessage)
+		}
+	})
+
+	t.Run("given existing file when exported then asks before replacing it", func(t *testing.T) {
+		m, fake := newFakeModel(t)
+		dir := t.TempDir()
+		m.config.Export = config.ExportConfig{Columns: []string{"notes"}, Directory: dir}
+		fake.AddTimeEntry(harvest.TimeEntry{SpentDate: "2025-01-15", Hours: 1, Notes: "Review"})
+		path := filepath.Join(dir, "harvest-2025-01-13-to-2025-01-19.csv")
+		if err := os.WriteFile(path, []byte("edited\n"), 0644); err != nil {
+			t.Fatal(err)
+		}
+		m, _ = pressKey(m, "x")
+
+		m, cmd := pressSpecialKey(m, tea.KeyEnter)
+		m = runCmd(t, m, cmd)
+
+		if m.currentView != ViewExport || m.exportForm.exporting {
+			t.Fatalf("expected the form open to confirm, got %v", m.currentView)
+		}
+		if m.statusMessage != "harvest-2025-01-13-to-2025-01-19.csv already exists. Press enter again to replace it" {
+			t.Errorf("unexpected status %q", m.statusMessage)
+		}
+		if data, _ := os.ReadFile(path); string(data) != "edited\n" {
+			t.Fatalf("expected the existing file left alone, got %q", data)
+		}
+
+		m, cmd = pressSpecialKey(m, tea.KeyEnter)
+		m = runCmd(t, m, cmd)
+
+		if m.currentView != ViewList {
+			t.Errorf("expected the form closed, got %v", m.currentView)
+		}
+		if data, _ := os.ReadFile(path); string(data) != "notes\nReview\n" {
+			t.Errorf("expected the file replaced, got %q", data)
+		}
+	})
+
+	t.Run("given Harvest error when exported then keeps the form open to retry", func(t *testing.T) {
+		m, fake := newFakeModel(t)
+		m.config.Export = config.ExportConfig{Directory: t.TempDir()}
+		fake.SetError(harvest.NewAPIError("failed to fetch time entries", http.StatusServiceUnavailable, ""))
+		m, _ = pressKey(m, "x")
+
+		m, cmd := pressSpecialKey(m, tea.KeyEnter)
+		m = runCmd(t, m, cmd)
+
+		if m.currentView != ViewExport || m.exportForm.exporting {
+			t.Fatalf("expected the form open for a retry, got %v", m.currentView)
+		}
+		if !strings.HasPrefix(m.statusMessage, "Export failed:") {
+			t.Errorf("expected failure message, got %q", m.statusMessage)
+		}
+	})
+
+	t.Run("given form when esc pressed then returns without exporting", func(t *testing.T) {
+		m, _ := newFakeModel(t)
+		m, _ = pressKey(m, "x")
+
+		m, cmd := pressSpecialKey(m, tea.KeyEsc)
+
+		if cmd != nil || m.currentView != ViewList || m.exportForm != nil {
+			t.Errorf("expected list view without a command, got %v", m.currentView)
+		}
+	})
+}
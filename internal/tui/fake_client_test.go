@@ -0,0 +1,131 @@
+package tui
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/planetargon/harvest-tui/internal/config"
+	"github.com/planetargon/harvest-tui/internal/harvest"
+	"github.com/planetargon/harvest-tui/internal/harvest/harvestfake"
+	"github.com/planetargon/harvest-tui/internal/state"
+)
+
+// newFakeModel returns a list view model backed by a seeded in-memory client.
+func newFakeModel(t *testing.T) (Model, *harvestfake.Client) {
+	t.Helper()
+	fake := harvestfake.New()
+	fake.AddProject(
+		harvest.Project{ID: 10, Name: "Website", Client: harvest.ProjectClient{ID: 1, Name: "Acme"}},
+		harvest.Task{ID: 100, Name: "Development", Billable: true},
+	)
+
+	cfg := &config.Config{Harvest: config.HarvestConfig{AccountID: "12345", AccessToken: "test-token"}}
+	appState := &state.State{Recents: []state.RecentEntry{}}
+	t.Setenv("HOME", t.TempDir())
+	m := NewModel(cfg, fake, appState, &harvest.User{FirstName: "Test", LastName: "User"})
+	m.currentView = ViewList
+	m.currentDate = time.Date(2025, 1, 15, 0, 0, 0, 0, time.UTC)
+	return m, fake
+}
+
+// runCmd executes cmd and feeds its message back into the model.
+func runCmd(t *testing.T, m Model, cmd tea.Cmd) Model {
+	t.Helper()
+	if cmd == nil {
+		t.Fatal("expected a command, got nil")
+	}
+	newModel, _ := m.Update(cmd())
+	return newModel.(Model)
+}
+
+func TestFakeClientCommands(t *testing.T) {
+	t.Run("given fake client when initial fetches run then list shows its entries and projects", func(t *testing.T) {
+		m, fake := newFakeModel(t)
+		fake.AddTimeEntry(harvest.TimeEntry{SpentDate: "2025-01-15", Hours: 1.5, Notes: "Standup"})
+		fake.AddTimeEntry(harvest.TimeEntry{SpentDate: "2025-01-14", Hours: 2, Notes: "Yesterday"})
+
+		m = runCmd(t, m, fetchTimeEntriesCmd(m.newTimeEntriesFetchContext(), m.harvestClient, m.currentDate))
+		m = runCmd(t, m, fetchProjectsWithTasksCmd(m.harvestClient))
+
+		if len(m.timeEntries) != 1 || m.timeEntries[0].Notes != "Standup" {
+			t.Errorf("expected only today's entry, got %+v", m.timeEntries)
+		}
+		if len(m.projectsWithTasks) != 1 || m.projectsWithTasks[0].Tasks[0].Name != "Development" {
+			t.Errorf("expected seeded project with its task, got %+v", m.projectsWithTasks)
+		}
+	})
+
+	t.Run("given confirm delete view when y pressed then entry is deleted from the client and the list", func(t *testing.T) {
+		m, fake := newFakeModel(t)
+		entry := fake.AddTimeEntry(harvest.TimeEntry{SpentDate: "2025-01-15", Hours: 1})
+		m.timeEntries = []harvest.TimeEntry{entry}
+		m.editingEntry = &m.timeEntries[0]
+		m.currentView = ViewConfirmDelete
+
+		newModel, cmd := m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("y")})
+		m = runCmd(t, newModel.(Model), cmd)
+
+		if len(fake.TimeEntries()) != 0 {
+			t.Errorf("expected entry to be deleted from the client, got %+v", fake.TimeEntries())
+		}
+		if len(m.timeEntries) != 0 {
+			t.Errorf("expected entry to be removed from the list, got %+v", m.timeEntries)
+		}
+		if m.statusMessage != "Time entry deleted successfully" {
+			t.Errorf("expected success message, got '%s'", m.statusMessage)
+		}
+	})
+
+	t.Run("given running entry when another is started then refetch shows the first stopped", func(t *testing.T) {
+		m, fake := newFakeModel(t)
+		running := fake.AddTimeEntry(harvest.TimeEntry{SpentDate: "2025-01-15", Hours: 1, IsRunning: true})
+		stopped := fake.AddTimeEntry(harvest.TimeEntry{SpentDate: "2025-01-15", Hours: 2})
+		m.timeEntries = []harvest.TimeEntry{stopped, running}
+		m.selectedEntryIndex = 0
+
+		newModel, cmd := m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("s")})
+		m = runCmd(t, newModel.(Model), cmd)
+		m = runCmd(t, m, fetchTimeEntriesCmd(m.newTimeEntriesFetchContext(), m.harvestClient, m.currentDate))
+
+		for _, entry := range m.timeEntries {
+			if entry.IsRunning != (entry.ID == stopped.ID) {
+				t.Errorf("expected only entry %d to be running, got %+v", stopped.ID, m.timeEntries)
+			}
+		}
+	})
+
+	t.Run("given locked entry in client when update submitted then shows Harvest's message", func(t *testing.T) {
+		m, fake := newFakeModel(t)
+		entry := fake.AddTimeEntry(harvest.TimeEntry{SpentDate: "2025-01-15", Hours: 1, IsLocked: true})
+		m.timeEntries = []harvest.TimeEntry{entry}
+		m.editingEntry = &m.timeEntries[0]
+		m.editHours = "2:00"
+		m.currentView = ViewEditEntry
+
+		m = runCmd(t, m, m.updateTimeEntry())
+
+		if m.statusMessage != "Failed to update entry: This time entry is locked and can't be edited" {
+			t.Errorf("expected locked message, got '%s'", m.statusMessage)
+		}
+	})
+
+	t.Run("given client failing when time entries fetched then shows error", func(t *testing.T) {
+		m, fake := newFakeModel(t)
+		fake.SetError(harvest.NewAPIError("failed to fetch time entries", http.StatusServiceUnavailable, ""))
+
+		m = runCmd(t, m, fetchTimeEntriesCmd(m.newTimeEntriesFetchContext(), m.harvestClient, m.currentDate))
+
+		if m.errorMessage != "Failed to fetch time entries: failed to fetch time entries with status 503" {
+			t.Errorf("expected fetch error, got '%s'", m.errorMessage)
+		}
+
+		var apiErr *harvest.APIError
+		_, err := fake.FetchProjectsContext(t.Context())
+		if !errors.As(err, &apiErr) || !apiErr.IsServerError() {
+			t.Errorf("expected server error from fake, got %v", err)
+		}
+	})
+}
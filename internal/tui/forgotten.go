@@ -0,0 +1,329 @@
+package tui
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"strings"
+	"time"
+
+	"github.com/charmbracelet/bubbles/textinput"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/planetargon/harvest-tui/internal/duration"
+	"github.com/planetargon/harvest-tui/internal/harvest"
+)
+
+// forgottenTimerPrompt is the state of the blocking prompt about a timer that
+// looks like it was left running.
+type forgottenTimerPrompt struct {
+	entry      harvest.TimeEntry
+	reason     string
+	trimInput  *textinput.Model // End time to trim to, while the user picks one
+	stopping   bool             // The stop is in flight
+	returnView ViewState        // View to go back to once the prompt is answered
+}
+
+// forgottenTimerStoppedMsg is sent when a forgotten timer has been stopped,
+// and trimmed when trimmedTo is set. When the stop went through but the trim
+// failed, entry is the stopped entry and trimErr says why.
+type forgottenTimerStoppedMsg struct {
+	entry     *harvest.TimeEntry
+	trimmedTo string
+	err       error
+	trimErr   error
+}
+
+// stopForgottenTimerCmd stops the timer unless it already is and, when update
+// is non-nil, trims it to the chosen end time.
+func stopForgottenTimerCmd(client harvest.API, entry harvest.TimeEntry, update *harvest.UpdateTimeEntryRequest, trimmedTo string) tea.Cmd {
+	return func() tea.Msg {
+		ctx := context.Background()
+		stopped := &entry
+		if entry.IsRunning {
+			var err error
+			stopped, err = client.StopTimeEntryContext(ctx, entry.ID)
+			if err != nil || update == nil {
+				return forgottenTimerStoppedMsg{entry: stopped, err: err}
+			}
+		}
+		trimmed, err := client.UpdateTimeEntryContext(ctx, entry.ID, *update)
+		if err != nil {
+			return forgottenTimerStoppedMsg{entry: stopped, trimErr: err}
+		}
+		return forgottenTimerStoppedMsg{entry: trimmed, trimmedTo: trimmedTo}
+	}
+}
+
+// forgottenTimerReason explains why the running timer looks forgotten at now,
+// or returns "" when it does not or the user chose to keep it. A timer still
+// running from a previous day always looks forgotten, unless both limits are
+// turned off.
+func (m Model) forgottenTimerReason(now time.Time) string {
+	entry := m.runningEntry
+	if entry == nil || entry.ID == m.keptTimerID || m.config == nil {
+		return ""
+	}
+	timer := m.config.Timer
+	if timer.MaxRunning <= 0 && timer.EndOfDay == "" {
+		return ""
+	}
+
+	date, err := time.ParseInLocation("2006-01-02", entry.SpentDate, time.Local)
+	if err != nil {
+		return ""
+	}
+	year, month, day := now.Date()
+	if date.Before(time.Date(year, month, day, 0, 0, 0, 0, time.Local)) {
+		return "Started " + date.Format("Mon, "+m.shortDateLayout()) + " and still running"
+	}
+
+	if timer.MaxRunning > 0 && m.runningEntryHours() >= timer.MaxRunning.Hours() {
+		return fmt.Sprintf("Running for %s, over the %s limit",
+			m.formatHours(m.runningEntryHours()), m.formatHours(timer.MaxRunning.Hours()))
+	}
+
+	if end, ok := timer.EndOfDayOn(date); ok && now.After(end) {
+		return "Still running after the end of the day at " + m.formatClockTime(end.Hour()*60+end.Minute())
+	}
+	return ""
+}
+
+// checkForgottenTimer raises the forgotten timer prompt when the running timer
+// looks forgotten. It waits for the list or week view, so it never interrupts
+// a form being filled in.
+func (m *Model) checkForgottenTimer() {
+	if m.forgottenTimer != nil || (m.currentView != ViewList && m.currentView != ViewWeek) {
+		return
+	}
+	reason := m.forgottenTimerReason(time.Now())
+	if reason == "" {
+		return
+	}
+	m.forgottenTimer = &forgottenTimerPrompt{
+		entry:      *m.runningEntry,
+		reason:     reason,
+		returnView: m.currentView,
+	}
+	m.currentView = ViewForgottenTimer
+	m.clearStatusMessage()
+}
+
+// forgottenTimerHours returns the prompted timer's hours as of now.
+func (m Model) forgottenTimerHours() float64 {
+	if m.runningEntry != nil && m.runningEntry.ID == m.forgottenTimer.entry.ID {
+		return m.runningEntryHours()
+	}
+	return m.forgottenTimer.entry.Hours
+}
+
+// suggestedTrimTime returns the end time offered when trimming: the end of
+// the day, or else when the timer went over its limit. It is empty when
+// neither falls on the entry's day.
+func (m Model) suggestedTrimTime(now time.Time) string {
+	date, err := time.ParseInLocation("2006-01-02", m.forgottenTimer.entry.SpentDate, time.Local)
+	if err != nil {
+		return ""
+	}
+	startedAt := now.Add(-time.Duration(m.forgottenTimerHours() * float64(time.Hour)))
+
+	end, ok := m.config.Timer.EndOfDayOn(date)
+	if !ok || !end.After(startedAt) || end.After(now) {
+		if m.config.Timer.MaxRunning <= 0 {
+			return ""
+		}
+		end = startedAt.Add(m.config.Timer.MaxRunning)
+	}
+	if end.After(now) || end.Format("2006-01-02") != m.forgottenTimer.entry.SpentDate {
+		return ""
+	}
+	return m.formatClockTime(end.Hour()*60 + end.Minute())
+}
+
+// trimForgottenTimer stops the prompted timer and trims it to the end time
+// typed in, which is a time of day on the entry's date.
+func (m Model) trimForgottenTimer(now time.Time) (Model, tea.Cmd) {
+	prompt := m.forgottenTimer
+	minutes, ok := duration.ParseClock(prompt.trimInput.Value())
+	date, err := time.ParseInLocation("2006-01-02", prompt.entry.SpentDate, time.Local)
+	if !ok || err != nil {
+		m.setStatusMessage("Invalid end time. Use a time like 5:30pm or 17:30")
+		return m, nil
+	}
+
+	end := date.Add(time.Duration(minutes) * time.Minute)
+	hours := m.forgottenTimerHours()
+	if end.After(now) {
+		m.setStatusMessage("Invalid end time: it is in the future")
+		return m, nil
+	}
+	trimmed := hours - now.Sub(end).Hours()
+	if trimmed <= 0 {
+		m.setStatusMessage("Invalid end time: the timer started later")
+		return m, nil
+	}
+
+	// Accounts tracking start and end times derive the hours from them
+	var update harvest.UpdateTimeEntryRequest
+	if prompt.entry.StartedTime != "" {
+		endedTime := duration.FormatClock(minutes)
+		update.EndedTime = &endedTime
+	} else {
+		trimmed = math.Round(trimmed*60) / 60
+		update.Hours = &trimmed
+	}
+
+	prompt.stopping = true
+	m.clearStatusMessage()
+	return m, stopForgottenTimerCmd(m.harvestClient, prompt.entry, &update, m.formatClockTime(minutes))
+}
+
+func (m Model) handleForgottenTimerKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	prompt := m.forgottenTimer
+	if prompt == nil || prompt.stopping {
+		return m, nil
+	}
+
+	// Typing the end time to trim to
+	if prompt.trimInput != nil {
+		switch msg.String() {
+		case "esc":
+			prompt.trimInput = nil
+			m.clearStatusMessage()
+			return m, nil
+		case "enter":
+			return m.trimForgottenTimer(time.Now())
+		}
+		var cmd tea.Cmd
+		*prompt.trimInput, cmd = prompt.trimInput.Update(msg)
+		return m, cmd
+	}
+
+	switch msg.String() {
+	case "s":
+		// A failed trim leaves the timer stopped already, untrimmed
+		if !prompt.entry.IsRunning {
+			m.currentView = prompt.returnView
+			m.forgottenTimer = nil
+			m.setStatusMessage("Timer stopped successfully")
+			return m, nil
+		}
+		prompt.stopping = true
+		m.clearStatusMessage()
+		return m, stopForgottenTimerCmd(m.harvestClient, prompt.entry, nil, "")
+	case "t":
+		trimInput := textinput.New()
+		trimInput.SetValue(m.suggestedTrimTime(time.Now()))
+		trimInput.Placeholder = "5:30pm or 17:30"
+		trimInput.Width = 20
+		trimInput.Focus()
+		prompt.trimInput = &trimInput
+		return m, nil
+	case "k", "esc":
+		m.keptTimerID = prompt.entry.ID
+		m.currentView = prompt.returnView
+		m.forgottenTimer = nil
+		return m, nil
+	}
+	return m, nil
+}
+
+// applyForgottenTimerStopped updates the entries with the stopped timer and
+// closes the prompt, or keeps it open to retry when stopping or trimming
+// failed.
+func (m *Model) applyForgottenTimerStopped(msg forgottenTimerStoppedMsg) {
+	prompt := m.forgottenTimer
+	if prompt == nil {
+		return
+	}
+	if msg.err != nil {
+		prompt.stopping = false
+		m.setStatusMessage("Failed to stop timer: " + describeError(msg.err))
+		return
+	}
+
+	m.applyStoppedEntry(*msg.entry)
+	if msg.trimErr != nil {
+		// Keep the prompt so the trim can be retried on the stopped entry
+		prompt.entry = *msg.entry
+		prompt.stopping = false
+		m.setStatusMessage("Timer stopped but could not trim: " + describeError(msg.trimErr))
+		return
+	}
+
+	m.currentView = prompt.returnView
+	m.forgottenTimer = nil
+	if msg.trimmedTo != "" {
+		m.setStatusMessage("Timer stopped and trimmed to " + msg.trimmedTo)
+	} else {
+		m.setStatusMessage("Timer stopped successfully")
+	}
+}
+
+// applyStoppedEntry replaces the stopped timer in the day's and week's entries
+// and clears it as the running timer.
+func (m *Model) applyStoppedEntry(stopped harvest.TimeEntry) {
+	for i, entry := range m.timeEntries {
+		if entry.ID == stopped.ID {
+			m.timeEntries[i] = stopped
+		}
+	}
+	for i, entry := range m.weekEntries {
+		if entry.ID == stopped.ID {
+			m.weekEntries[i] = stopped
+		}
+	}
+	if m.runningEntry != nil && m.runningEntry.ID == stopped.ID {
+		m.setRunningEntry(nil)
+	}
+}
+
+func (m Model) renderForgottenTimerView() string {
+	width := m.shellWidth()
+	prompt := m.forgottenTimer
+
+	titleBar := m.renderTitleBar()
+
+	breadcrumb := "  " + WarningText.Render("Timer Still Running")
+
+	divider := "  " + RenderDividerWidth(width-4)
+
+	entry := prompt.entry
+	contentLines := []string{titleBar, breadcrumb, divider, "",
+		"  " + AccentText.Render(prompt.reason),
+		"",
+		"  " + MutedText.Render(fmt.Sprintf("%s → %s → %s", entry.Client.Name, entry.Project.Name, entry.Task.Name)),
+	}
+	if entry.Notes != "" {
+		contentLines = append(contentLines, "  "+MutedText.Render("Notes: "+entry.Notes))
+	}
+	contentLines = append(contentLines, "  "+MutedText.Render("Duration: "+m.formatHours(m.forgottenTimerHours())), "")
+
+	var footerKeys []string
+	switch {
+	case prompt.stopping:
+		contentLines = append(contentLines, "  "+m.spinner.View()+" "+MutedText.Render("Stopping timer..."))
+	case prompt.trimInput != nil:
+		contentLines = append(contentLines, "  "+AccentText.Render("Stop at:")+" "+prompt.trimInput.View())
+		footerKeys = []string{
+			RenderKeybinding("enter", "stop and trim"),
+			RenderKeybinding("esc", "back"),
+		}
+	default:
+		contentLines = append(contentLines,
+			"  "+KeyStyle.Render("s")+"  Stop now",
+			"  "+KeyStyle.Render("t")+"  Stop and trim to an end time",
+			"  "+KeyStyle.Render("k")+"  Keep it running",
+		)
+		footerKeys = []string{
+			RenderKeybinding("s", "stop"),
+			RenderKeybinding("t", "trim"),
+			RenderKeybinding("k", "keep"),
+		}
+	}
+
+	if statusLine := m.renderStatusLine(); statusLine != "" {
+		contentLines = append(contentLines, "", statusLine)
+	}
+
+	return m.buildShellBox(strings.Join(contentLines, "\n"), width, footerKeys)
+}
@@ -0,0 +1,243 @@
+package tui
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/planetargon/harvest-tui/internal/config"
+	"github.com/planetargon/harvest-tui/internal/harvest"
+	"github.com/planetargon/harvest-tui/internal/harvest/harvestfake"
+)
+
+// newForgottenTimerModel returns a fake-backed list model with the default
+// timer limits and a timer left running since 2025-01-15.
+func newForgottenTimerModel(t *testing.T) (Model, *harvestfake.Client) {
+	t.Helper()
+	m, fake := newFakeModel(t)
+	m.config.Timer = config.DefaultTimerConfig()
+	fake.AddTimeEntry(harvest.TimeEntry{
+		ID:        7,
+		SpentDate: "2025-01-15",
+		Hours:     10,
+		Notes:     "Sprint planning",
+		IsRunning: true,
+		Client:    harvest.TimeEntryClient{ID: 1, Name: "Acme"},
+		Project:   harvest.TimeEntryProject{ID: 10, Name: "Website"},
+		Task:      harvest.TimeEntryTask{ID: 100, Name: "Development"},
+	})
+	return m, fake
+}
+
+// flakyUpdateAPI fails the first update and counts the stops.
+type flakyUpdateAPI struct {
+	*harvestfake.Client
+	updates, stops int
+}
+
+func (a *flakyUpdateAPI) UpdateTimeEntryContext(ctx context.Context, id int, request harvest.UpdateTimeEntryRequest) (*harvest.TimeEntry, error) {
+	a.updates++
+	if a.updates == 1 {
+		return nil, harvest.NewAPIError("failed to update time entry", http.StatusInternalServerError, "")
+	}
+	return a.Client.UpdateTimeEntryContext(ctx, id, request)
+}
+
+func (a *flakyUpdateAPI) StopTimeEntryContext(ctx context.Context, id int) (*harvest.TimeEntry, error) {
+	a.stops++
+	return a.Client.StopTimeEntryContext(ctx, id)
+}
+
+func pressKey(m Model, key string) (Model, tea.Cmd) {
+	newModel, cmd := m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune(key)})
+	return newModel.(Model), cmd
+}
+
+func TestForgottenTimer(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("given timer running since a previous day when found at startup then prompts about it", func(t *testing.T) {
+		m, _ := newForgottenTimerModel(t)
+
+		m = runCmd(t, m, fetchRunningEntryCmd(m.harvestClient))
+
+		if m.currentView != ViewForgottenTimer {
+			t.Fatalf("expected ViewForgottenTimer, got %v", m.currentView)
+		}
+		view := m.View()
+		if !strings.Contains(view, "Started Wed, Jan 15 and still running") {
+			t.Errorf("expected reason with the timer's date, got:\n%s", view)
+		}
+		if !strings.Contains(view, "Stop and trim to an end time") {
+			t.Error("expected the prompt to offer trimming")
+		}
+		if blocked, _ := pressKey(m, "?"); blocked.currentView != ViewForgottenTimer {
+			t.Error("expected the prompt to block other keys")
+		}
+	})
+
+	t.Run("given timer running today past the limit when ticked then prompts, but not over a form", func(t *testing.T) {
+		m, _ := newFakeModel(t)
+		m.config.Timer = config.TimerConfig{MaxRunning: 8 * time.Hour}
+		m.setRunningEntry(&harvest.TimeEntry{ID: 7, SpentDate: time.Now().Format("2006-01-02"), Hours: 9, IsRunning: true})
+		m.currentView = ViewNewEntry
+
+		newModel, _ := m.Update(tickMsg(time.Now()))
+		m = newModel.(Model)
+		if m.currentView != ViewNewEntry {
+			t.Fatalf("expected the form to stay open, got %v", m.currentView)
+		}
+
+		m.currentView = ViewList
+		newModel, _ = m.Update(tickMsg(time.Now()))
+		m = newModel.(Model)
+		if m.currentView != ViewForgottenTimer {
+			t.Fatalf("expected ViewForgottenTimer, got %v", m.currentView)
+		}
+		if !strings.Contains(m.forgottenTimer.reason, "over the 8:00 limit") {
+			t.Errorf("expected limit reason, got '%s'", m.forgottenTimer.reason)
+		}
+	})
+
+	t.Run("given limits turned off when old timer found then does not prompt", func(t *testing.T) {
+		m, _ := newForgottenTimerModel(t)
+		m.config.Timer = config.TimerConfig{}
+
+		m = runCmd(t, m, fetchRunningEntryCmd(m.harvestClient))
+
+		if m.currentView != ViewList {
+			t.Errorf("expected ViewList, got %v", m.currentView)
+		}
+	})
+
+	t.Run("given prompt when s pressed then stops the timer and returns to the list", func(t *testing.T) {
+		m, fake := newForgottenTimerModel(t)
+		m = runCmd(t, m, fetchRunningEntryCmd(m.harvestClient))
+
+		m, cmd := pressKey(m, "s")
+		if _, again := pressKey(m, "s"); again != nil {
+			t.Error("expected no second stop while one is in flight")
+		}
+		m = runCmd(t, m, cmd)
+
+		if m.currentView != ViewList || m.runningEntry != nil {
+			t.Errorf("expected list with no running timer, got view %v and %+v", m.currentView, m.runningEntry)
+		}
+		if m.statusMessage != "Timer stopped successfully" {
+			t.Errorf("expected stopped status, got '%s'", m.statusMessage)
+		}
+		if running, _ := fake.FetchRunningTimeEntryContext(ctx); running != nil {
+			t.Errorf("expected timer stopped in Harvest, got %+v", running)
+		}
+	})
+
+	t.Run("given prompt when trimmed to an end time then stops the timer with the hours up to it", func(t *testing.T) {
+		m, fake := newForgottenTimerModel(t)
+		m = runCmd(t, m, fetchRunningEntryCmd(m.harvestClient))
+		m, _ = pressKey(m, "t")
+		m.forgottenTimer.trimInput.SetValue("5:30pm")
+
+		// Ten hours at 8:00pm means the timer started at 10:00am
+		m, cmd := m.trimForgottenTimer(time.Date(2025, 1, 15, 20, 0, 0, 0, time.Local))
+		m = runCmd(t, m, cmd)
+
+		if m.statusMessage != "Timer stopped and trimmed to 5:30pm" {
+			t.Errorf("expected trimmed status, got '%s'", m.statusMessage)
+		}
+		entries, _ := fake.FetchTimeEntriesContext(ctx, "2025-01-15")
+		if len(entries) != 1 || entries[0].IsRunning || entries[0].Hours != 7.5 {
+			t.Errorf("expected stopped entry trimmed to 7:30, got %+v", entries)
+		}
+	})
+
+	t.Run("given trim failing after the stop when retried then only trims the stopped timer", func(t *testing.T) {
+		m, fake := newForgottenTimerModel(t)
+		api := &flakyUpdateAPI{Client: fake}
+		m.harvestClient = api
+		m = runCmd(t, m, fetchRunningEntryCmd(m.harvestClient))
+		m.timeEntries, _ = fake.FetchTimeEntriesContext(ctx, "2025-01-15")
+		m, _ = pressKey(m, "t")
+		m.forgottenTimer.trimInput.SetValue("5:30pm")
+		now := time.Date(2025, 1, 15, 20, 0, 0, 0, time.Local)
+
+		m, cmd := m.trimForgottenTimer(now)
+		m = runCmd(t, m, cmd)
+
+		if m.currentView != ViewForgottenTimer || !strings.HasPrefix(m.statusMessage, "Timer stopped but could not trim: ") {
+			t.Fatalf("expected the prompt kept with a trim error, got view %v and '%s'", m.currentView, m.statusMessage)
+		}
+		if m.runningEntry != nil || m.timeEntries[0].IsRunning {
+			t.Errorf("expected the stopped timer applied, got %+v", m.timeEntries)
+		}
+
+		m, cmd = m.trimForgottenTimer(now)
+		m = runCmd(t, m, cmd)
+
+		if api.stops != 1 {
+			t.Errorf("expected a single stop, got %d", api.stops)
+		}
+		if m.statusMessage != "Timer stopped and trimmed to 5:30pm" || m.currentView != ViewList {
+			t.Errorf("expected trimmed status on the list, got view %v and '%s'", m.currentView, m.statusMessage)
+		}
+		entries, _ := fake.FetchTimeEntriesContext(ctx, "2025-01-15")
+		if len(entries) != 1 || entries[0].IsRunning || entries[0].Hours != 7.5 {
+			t.Errorf("expected stopped entry trimmed to 7:30, got %+v", entries)
+		}
+	})
+
+	t.Run("given end time outside the timer when trimmed then shows an error and keeps the prompt", func(t *testing.T) {
+		m, _ := newForgottenTimerModel(t)
+		m = runCmd(t, m, fetchRunningEntryCmd(m.harvestClient))
+		m, _ = pressKey(m, "t")
+		now := time.Date(2025, 1, 15, 20, 0, 0, 0, time.Local)
+
+		for value, want := range map[string]string{
+			"9pm":   "Invalid end time: it is in the future",
+			"9am":   "Invalid end time: the timer started later",
+			"later": "Invalid end time. Use a time like 5:30pm or 17:30",
+		} {
+			m.forgottenTimer.trimInput.SetValue(value)
+			trimmed, cmd := m.trimForgottenTimer(now)
+			if cmd != nil || trimmed.statusMessage != want {
+				t.Errorf("expected '%s' for %s, got '%s'", want, value, trimmed.statusMessage)
+			}
+			if trimmed.currentView != ViewForgottenTimer {
+				t.Errorf("expected prompt kept for %s", value)
+			}
+		}
+	})
+
+	t.Run("given end of day configured when trimming then suggests it", func(t *testing.T) {
+		m, _ := newForgottenTimerModel(t)
+		m.config.Timer.EndOfDay = "17:00"
+		m = runCmd(t, m, fetchRunningEntryCmd(m.harvestClient))
+
+		got := m.suggestedTrimTime(time.Date(2025, 1, 15, 20, 0, 0, 0, time.Local))
+
+		if got != "5:00pm" {
+			t.Errorf("expected 5:00pm suggested, got '%s'", got)
+		}
+	})
+
+	t.Run("given prompt when k pressed then keeps the timer and does not ask again", func(t *testing.T) {
+		m, fake := newForgottenTimerModel(t)
+		m = runCmd(t, m, fetchRunningEntryCmd(m.harvestClient))
+
+		m, cmd := pressKey(m, "k")
+		if cmd != nil || m.currentView != ViewList {
+			t.Fatalf("expected back on the list, got %v", m.currentView)
+		}
+		newModel, _ := m.Update(tickMsg(time.Now()))
+		m = newModel.(Model)
+
+		if m.currentView != ViewList {
+			t.Errorf("expected no second prompt, got %v", m.currentView)
+		}
+		if running, _ := fake.FetchRunningTimeEntryContext(ctx); running == nil {
+			t.Error("expected the timer to keep running")
+		}
+	})
+}
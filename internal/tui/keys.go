@@ -16,12 +16,22 @@ type KeyMap struct {
 	NextDay key.Binding
 	Today   key.Binding
 
+	// Week view navigation
+	Week     key.Binding
+	PrevWeek key.Binding
+	NextWeek key.Binding
+
 	// Time entry actions
 	New       key.Binding
 	Edit      key.Binding
 	Delete    key.Binding
 	StartStop key.Binding
 
+	// Data
+	RefreshProjects key.Binding
+	GoToRunning     key.Binding
+	Export          key.Binding
+
 	// Selection and confirmation
 	Select  key.Binding
 	Confirm key.Binding
@@ -71,6 +81,20 @@ func DefaultKeyMap() KeyMap {
 			key.WithHelp("t", "jump to today"),
 		),
 
+		// Week view navigation
+		Week: key.NewBinding(
+			key.WithKeys("w"),
+			key.WithHelp("w", "weekly timesheet"),
+		),
+		PrevWeek: key.NewBinding(
+			key.WithKeys("["),
+			key.WithHelp("[", "previous week"),
+		),
+		NextWeek: key.NewBinding(
+			key.WithKeys("]"),
+			key.WithHelp("]", "next week"),
+		),
+
 		// Time entry actions
 		New: key.NewBinding(
 			key.WithKeys("n"),
@@ -89,6 +113,20 @@ func DefaultKeyMap() KeyMap {
 			key.WithHelp("s", "start/stop timer"),
 		),
 
+		// Data
+		RefreshProjects: key.NewBinding(
+			key.WithKeys("R"),
+			key.WithHelp("R", "refresh projects"),
+		),
+		GoToRunning: key.NewBinding(
+			key.WithKeys("g"),
+			key.WithHelp("g", "go to running timer"),
+		),
+		Export: key.NewBinding(
+			key.WithKeys("x"),
+			key.WithHelp("x", "export CSV"),
+		),
+
 		// Selection and confirmation
 		Select: key.NewBinding(
 			key.WithKeys("enter", " "),
@@ -124,9 +162,9 @@ func (k KeyMap) ShortHelp() []key.Binding {
 func (k KeyMap) FullHelp() [][]key.Binding {
 	return [][]key.Binding{
 		// First column: Navigation
-		{k.Up, k.Down, k.PrevDay, k.NextDay, k.Today},
+		{k.Up, k.Down, k.PrevDay, k.NextDay, k.Today, k.Week},
 		// Second column: Actions
-		{k.New, k.Edit, k.Delete, k.StartStop},
+		{k.New, k.Edit, k.Delete, k.StartStop, k.Export},
 		// Third column: General
 		{k.Select, k.Help, k.Back, k.Quit},
 	}
@@ -135,12 +173,20 @@ func (k KeyMap) FullHelp() [][]key.Binding {
 // ListViewHelp returns help specific to the list view.
 func (k KeyMap) ListViewHelp() [][]key.Binding {
 	return [][]key.Binding{
-		{k.Up, k.Down, k.PrevDay, k.NextDay, k.Today},
-		{k.New, k.Edit, k.Delete, k.StartStop},
+		{k.Up, k.Down, k.PrevDay, k.NextDay, k.Today, k.Week},
+		{k.New, k.Edit, k.Delete, k.StartStop, k.Export},
 		{k.Help, k.Quit},
 	}
 }
 
+// WeekViewHelp returns help for the weekly timesheet view.
+func (k KeyMap) WeekViewHelp() [][]key.Binding {
+	return [][]key.Binding{
+		{k.Up, k.Down, k.PrevDay, k.NextDay, k.PrevWeek, k.NextWeek, k.Today},
+		{k.Select, k.Export, k.Back, k.Help, k.Quit},
+	}
+}
+
 // SelectionViewHelp returns help for selection views (project/task selection).
 func (k KeyMap) SelectionViewHelp() [][]key.Binding {
 	return [][]key.Binding{
@@ -8,12 +8,13 @@ import (
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/planetargon/harvest-tui/internal/config"
 	"github.com/planetargon/harvest-tui/internal/harvest"
+	"github.com/planetargon/harvest-tui/internal/harvest/harvestfake"
 	"github.com/planetargon/harvest-tui/internal/state"
 )
 
 func newLoadingModel() Model {
 	cfg := &config.Config{Harvest: config.HarvestConfig{AccountID: "12345", AccessToken: "test-token"}}
-	client := harvest.NewClient("12345", "test-token")
+	client := harvestfake.New()
 	appState := &state.State{Recents: []state.RecentEntry{}}
 	return NewModel(cfg, client, appState, &harvest.User{FirstName: "Test", LastName: "User"})
 }
@@ -31,6 +32,7 @@ func TestLoadingView(t *testing.T) {
 		model := newLoadingModel()
 
 		msg := timeEntriesFetchedMsg{
+			date:    model.currentDate.Format("2006-01-02"),
 			entries: []harvest.TimeEntry{{ID: 1, Notes: "Test"}},
 		}
 		newModel, _ := model.Update(msg)
@@ -77,6 +79,7 @@ func TestLoadingView(t *testing.T) {
 
 		// First: time entries arrive
 		msg1 := timeEntriesFetchedMsg{
+			date:    model.currentDate.Format("2006-01-02"),
 			entries: []harvest.TimeEntry{{ID: 1, Notes: "Test"}},
 		}
 		newModel, _ := model.Update(msg1)
@@ -111,6 +114,7 @@ func TestLoadingView(t *testing.T) {
 
 		// Second: time entries arrive
 		msg2 := timeEntriesFetchedMsg{
+			date:    model.currentDate.Format("2006-01-02"),
 			entries: []harvest.TimeEntry{},
 		}
 		newModel, _ = m.Update(msg2)
@@ -126,7 +130,8 @@ func TestLoadingView(t *testing.T) {
 
 		// Both fetches return errors
 		msg1 := timeEntriesFetchedMsg{
-			err: fmt.Errorf("network error"),
+			date: model.currentDate.Format("2006-01-02"),
+			err:  fmt.Errorf("network error"),
 		}
 		newModel, _ := model.Update(msg1)
 		m := newModel.(Model)
@@ -0,0 +1,191 @@
+package tui
+
+import (
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/planetargon/harvest-tui/internal/harvest"
+	"github.com/planetargon/harvest-tui/internal/harvest/harvestfake"
+)
+
+// newMoveModel returns a model editing an Acme/Website entry on 2025-01-15,
+// with Globex/Mobile App and single-task Initech/Support projects to move it to.
+func newMoveModel(t *testing.T) (Model, *harvestfake.Client) {
+	t.Helper()
+	m, fake := newFakeModel(t)
+	fake.AddProject(
+		harvest.Project{ID: 20, Name: "Mobile App", Client: harvest.ProjectClient{ID: 2, Name: "Globex"}},
+		harvest.Task{ID: 200, Name: "Design", Billable: false},
+		harvest.Task{ID: 201, Name: "QA", Billable: true},
+	)
+	fake.AddProject(
+		harvest.Project{ID: 30, Name: "Support", Client: harvest.ProjectClient{ID: 3, Name: "Initech"}},
+		harvest.Task{ID: 300, Name: "Triage", Billable: true},
+	)
+	fake.AddTimeEntry(harvest.TimeEntry{
+		SpentDate:  "2025-01-15",
+		Hours:      1.5,
+		Notes:      "Wrong place",
+		IsBillable: true,
+		Client:     harvest.TimeEntryClient{ID: 1, Name: "Acme"},
+		Project:    harvest.TimeEntryProject{ID: 10, Name: "Website"},
+		Task:       harvest.TimeEntryTask{ID: 100, Name: "Development"},
+	})
+
+	m = runCmd(t, m, fetchTimeEntriesCmd(m.newTimeEntriesFetchContext(), m.harvestClient, m.currentDate))
+	m = runCmd(t, m, fetchProjectsWithTasksCmd(m.harvestClient))
+
+	newModel, _ := m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("e")})
+	return newModel.(Model), fake
+}
+
+func TestMoveEntry(t *testing.T) {
+	t.Run("given edit form when opened then shows project and date fields", func(t *testing.T) {
+		m, _ := newMoveModel(t)
+
+		view := m.View()
+		if !strings.Contains(view, "Acme → Website") {
+			t.Error("expected project field to show the entry's client and project")
+		}
+		if !strings.Contains(view, "Wed, Jan 15, 2025") {
+			t.Error("expected date field to show the entry's spent date")
+		}
+	})
+
+	t.Run("given project field when project with several tasks chosen then task must be picked from it", func(t *testing.T) {
+		m, fake := newMoveModel(t)
+		m.editCurrentField = 0
+
+		newModel, _ := m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+		m = newModel.(Model)
+		if m.currentView != ViewSelectProject {
+			t.Fatalf("expected project selection, got %v", m.currentView)
+		}
+
+		for i, item := range m.projectList.Items() {
+			if p, ok := item.(projectItem); ok && p.project.ID == 20 {
+				m.projectList.Select(i)
+			}
+		}
+		newModel, _ = m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+		m = newModel.(Model)
+		if m.currentView != ViewSelectTask {
+			t.Fatalf("expected task selection for the new project, got %v", m.currentView)
+		}
+		if m.editProject.ID != 10 {
+			t.Error("expected project to change only once a task is picked")
+		}
+
+		m.taskList.Select(0) // Design, non-billable
+		newModel, _ = m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+		m = newModel.(Model)
+		if m.currentView != ViewEditEntry {
+			t.Fatalf("expected edit view, got %v", m.currentView)
+		}
+		if m.editProject.ID != 20 || m.editTask.ID != 200 {
+			t.Errorf("expected Mobile App / Design, got project %d task %d", m.editProject.ID, m.editTask.ID)
+		}
+		if m.editBillable {
+			t.Error("expected billable to follow the new task's default")
+		}
+
+		m = runCmd(t, m, m.updateTimeEntry())
+
+		stored := fake.TimeEntries()[0]
+		if stored.Project.ID != 20 || stored.Task.ID != 200 || stored.Client.Name != "Globex" {
+			t.Errorf("expected entry moved to Globex/Mobile App/Design, got %+v", stored)
+		}
+		if m.currentView != ViewList {
+			t.Errorf("expected list view after saving, got %v", m.currentView)
+		}
+	})
+
+	t.Run("given project field when project with one task chosen then task is taken directly", func(t *testing.T) {
+		m, _ := newMoveModel(t)
+		m.openProjectSelectionForEdit()
+
+		m.selectProjectForEdit(harvest.Project{ID: 30, Name: "Support", Client: harvest.ProjectClient{ID: 3, Name: "Initech"}})
+
+		if m.currentView != ViewEditEntry {
+			t.Fatalf("expected edit view, got %v", m.currentView)
+		}
+		if m.editProject.ID != 30 || m.editTask.ID != 300 {
+			t.Errorf("expected Support / Triage, got project %d task %d", m.editProject.ID, m.editTask.ID)
+		}
+	})
+
+	t.Run("given project selection during edit when esc pressed then keeps current project", func(t *testing.T) {
+		m, _ := newMoveModel(t)
+		m.openProjectSelectionForEdit()
+
+		newModel, _ := m.Update(tea.KeyMsg{Type: tea.KeyEsc})
+		m = newModel.(Model)
+
+		if m.currentView != ViewEditEntry {
+			t.Errorf("expected edit view, got %v", m.currentView)
+		}
+		if m.editProject.ID != 10 || m.editTask.ID != 100 {
+			t.Errorf("expected project and task unchanged, got project %d task %d", m.editProject.ID, m.editTask.ID)
+		}
+	})
+
+	t.Run("given task not assigned to the edit project when saved then fails without calling Harvest", func(t *testing.T) {
+		m, fake := newMoveModel(t)
+		m.editProject = &harvest.Project{ID: 20, Name: "Mobile App"}
+
+		newModel, cmd := m.Update(tea.KeyMsg{Type: tea.KeyCtrlS})
+		m = newModel.(Model)
+
+		if cmd != nil {
+			t.Error("expected no save command")
+		}
+		if m.statusMessage != "Select a task for the new project" {
+			t.Errorf("expected task validation error, got %q", m.statusMessage)
+		}
+		if fake.TimeEntries()[0].Project.ID != 10 {
+			t.Error("expected entry to stay on its project")
+		}
+	})
+
+	t.Run("given date field when arrows pressed then moves the date by a day or week", func(t *testing.T) {
+		m, _ := newMoveModel(t)
+		m.editCurrentField = 2
+
+		for _, key := range []tea.KeyMsg{
+			{Type: tea.KeyRight},
+			{Type: tea.KeyRight},
+			{Type: tea.KeyLeft},
+			{Type: tea.KeyRunes, Runes: []rune("]")},
+		} {
+			newModel, _ := m.Update(key)
+			m = newModel.(Model)
+		}
+
+		if got := m.editDate.Format("2006-01-02"); got != "2025-01-23" {
+			t.Errorf("expected 2025-01-23, got %s", got)
+		}
+		if m.editNotes != "Wrong place" {
+			t.Errorf("expected notes untouched by date keys, got %q", m.editNotes)
+		}
+	})
+
+	t.Run("given date changed when saved then entry moves to that day and leaves the list", func(t *testing.T) {
+		m, fake := newMoveModel(t)
+		m.editCurrentField = 2
+		newModel, _ := m.Update(tea.KeyMsg{Type: tea.KeyLeft})
+		m = newModel.(Model)
+
+		m = runCmd(t, m, m.updateTimeEntry())
+
+		if got := fake.TimeEntries()[0].SpentDate; got != "2025-01-14" {
+			t.Errorf("expected spent date 2025-01-14, got %s", got)
+		}
+		if len(m.timeEntries) != 0 {
+			t.Errorf("expected moved entry removed from today's list, got %d entries", len(m.timeEntries))
+		}
+		if m.statusMessage != "Time entry moved to Tue, Jan 14" {
+			t.Errorf("expected moved status, got %q", m.statusMessage)
+		}
+	})
+}
@@ -0,0 +1,151 @@
+package tui
+
+import (
+	"net/http"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/planetargon/harvest-tui/internal/harvest"
+	"github.com/planetargon/harvest-tui/internal/state"
+)
+
+func cachedProjects() []harvest.ProjectWithTasks {
+	return []harvest.ProjectWithTasks{
+		{
+			Project: harvest.Project{ID: 10, Name: "Website", Client: harvest.ProjectClient{ID: 1, Name: "Acme"}},
+			Tasks:   []harvest.Task{{ID: 100, Name: "Development", Billable: true}},
+		},
+		{
+			Project: harvest.Project{ID: 20, Name: "Mobile", Client: harvest.ProjectClient{ID: 2, Name: "Globex"}},
+			Tasks:   []harvest.Task{{ID: 100, Name: "Development"}, {ID: 101, Name: "Design"}},
+		},
+	}
+}
+
+func TestProjectCache(t *testing.T) {
+	t.Run("given cached projects when time entries arrive then leaves loading without waiting for projects", func(t *testing.T) {
+		model := newLoadingModel().WithCachedProjects(cachedProjects())
+
+		newModel, _ := model.Update(timeEntriesFetchedMsg{date: model.currentDate.Format("2006-01-02")})
+		m := newModel.(Model)
+
+		if m.currentView != ViewList {
+			t.Errorf("expected ViewList, got %v", m.currentView)
+		}
+		if len(m.projectsWithTasks) != 2 {
+			t.Errorf("expected cached projects, got %d", len(m.projectsWithTasks))
+		}
+	})
+
+	t.Run("given empty cache when time entries arrive then still waits for projects", func(t *testing.T) {
+		model := newLoadingModel().WithCachedProjects(nil)
+
+		newModel, _ := model.Update(timeEntriesFetchedMsg{date: model.currentDate.Format("2006-01-02")})
+
+		if newModel.(Model).currentView != ViewLoading {
+			t.Errorf("expected ViewLoading, got %v", newModel.(Model).currentView)
+		}
+	})
+
+	t.Run("given project list open when fresh projects arrive then keeps the highlighted project", func(t *testing.T) {
+		model := newTestModel().WithCachedProjects(cachedProjects())
+		model.currentView = ViewSelectProject
+		model.updateProjectList()
+		model.projectList.Select(1) // Globex → Mobile
+
+		fresh := append([]harvest.ProjectWithTasks{{
+			Project: harvest.Project{ID: 5, Name: "Archive", Client: harvest.ProjectClient{ID: 3, Name: "Aardvark"}},
+			Tasks:   []harvest.Task{{ID: 100, Name: "Development"}},
+		}}, cachedProjects()...)
+		newModel, _ := model.Update(projectsWithTasksFetchedMsg{projectsWithTasks: fresh})
+		m := newModel.(Model)
+
+		if len(m.projectList.Items()) != 3 {
+			t.Fatalf("expected 3 projects listed, got %d", len(m.projectList.Items()))
+		}
+		item, ok := m.projectList.SelectedItem().(projectItem)
+		if !ok || item.project.ID != 20 {
+			t.Errorf("expected Mobile to stay highlighted, got %+v", m.projectList.SelectedItem())
+		}
+	})
+
+	t.Run("given task list open when fresh projects arrive then shows the project's new tasks", func(t *testing.T) {
+		model := newTestModel().WithCachedProjects(cachedProjects())
+		model.currentView = ViewSelectTask
+		model.selectedProject = &cachedProjects()[1].Project
+		model.updateTaskList(cachedProjects()[1].Tasks)
+		model.taskList.Select(1) // Design
+
+		fresh := cachedProjects()
+		fresh[1].Tasks = append([]harvest.Task{{ID: 99, Name: "Admin"}}, fresh[1].Tasks...)
+		newModel, _ := model.Update(projectsWithTasksFetchedMsg{projectsWithTasks: fresh})
+		m := newModel.(Model)
+
+		if len(m.taskList.Items()) != 3 {
+			t.Fatalf("expected 3 tasks listed, got %d", len(m.taskList.Items()))
+		}
+		item, ok := m.taskList.SelectedItem().(taskItem)
+		if !ok || item.task.ID != 101 {
+			t.Errorf("expected Design to stay highlighted, got %+v", m.taskList.SelectedItem())
+		}
+	})
+
+	t.Run("given list view when R pressed then refetches projects and reports the count", func(t *testing.T) {
+		m, _ := newFakeModel(t)
+
+		newModel, cmd := m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("R")})
+		m = newModel.(Model)
+		if !m.refreshingProjects || m.statusMessage != "Refreshing projects..." {
+			t.Fatalf("expected refresh in progress, got status '%s'", m.statusMessage)
+		}
+		if _, again := m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("R")}); again != nil {
+			t.Error("expected no second fetch while one is in flight")
+		}
+
+		m = runCmd(t, m, cmd)
+
+		if m.refreshingProjects {
+			t.Error("expected refresh to finish")
+		}
+		if m.statusMessage != "Projects refreshed (1 project)" {
+			t.Errorf("expected refreshed status, got '%s'", m.statusMessage)
+		}
+	})
+
+	t.Run("given fresh projects when fetched then saves them to the cache", func(t *testing.T) {
+		m, _ := newFakeModel(t)
+
+		newModel, saveCmd := m.Update(fetchProjectsWithTasksCmd(m.harvestClient)())
+		m = newModel.(Model)
+		if saveCmd == nil {
+			t.Fatal("expected a command to save the cache")
+		}
+		saveCmd()
+
+		cache, err := state.LoadProjectCache(m.config.Harvest.AccountID)
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		if cache == nil || len(cache.Projects) != 1 || cache.Projects[0].Project.Name != "Website" {
+			t.Errorf("expected fetched project cached, got %+v", cache)
+		}
+	})
+
+	t.Run("given cached projects when refresh fails then keeps them and shows a status", func(t *testing.T) {
+		m, fake := newFakeModel(t)
+		m = m.WithCachedProjects(cachedProjects())
+		fake.SetError(harvest.NewAPIError("failed to fetch project assignments", http.StatusServiceUnavailable, ""))
+
+		m = runCmd(t, m, fetchProjectsWithTasksCmd(m.harvestClient))
+
+		if m.errorMessage != "" {
+			t.Errorf("expected no error screen, got '%s'", m.errorMessage)
+		}
+		if len(m.projectsWithTasks) != 2 {
+			t.Errorf("expected cached projects kept, got %d", len(m.projectsWithTasks))
+		}
+		if m.statusMessage == "" {
+			t.Error("expected a status about the failed refresh")
+		}
+	})
+}
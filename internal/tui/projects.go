@@ -0,0 +1,102 @@
+package tui
+
+import (
+	"fmt"
+
+	"github.com/charmbracelet/bubbles/list"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/planetargon/harvest-tui/internal/harvest"
+	"github.com/planetargon/harvest-tui/internal/state"
+)
+
+// WithCachedProjects returns a copy of the model that starts with projects
+// from the on-disk cache, so the list opens without waiting for them. The
+// fresh list fetched by Init replaces them when it arrives.
+func (m Model) WithCachedProjects(projects []harvest.ProjectWithTasks) Model {
+	if len(projects) > 0 {
+		m.projectsWithTasks = projects
+		m.projectsLoaded = true
+	}
+	return m
+}
+
+// refreshProjects fetches the project list again, e.g. after being added to
+// a project since the cache was written.
+func (m Model) refreshProjects() (Model, tea.Cmd) {
+	if m.refreshingProjects {
+		return m, nil
+	}
+	m.refreshingProjects = true
+	m.setStatusMessage("Refreshing projects...")
+	return m, fetchProjectsWithTasksCmd(m.harvestClient)
+}
+
+// applyFreshProjects swaps in a newly fetched project list, rebuilding any
+// open project or task list with the same item still highlighted.
+func (m *Model) applyFreshProjects(projects []harvest.ProjectWithTasks) {
+	m.projectsWithTasks = projects
+
+	switch m.currentView {
+	case ViewSelectProject:
+		selectedID := 0
+		if item, ok := m.projectList.SelectedItem().(projectItem); ok {
+			selectedID = item.project.ID
+		}
+		m.updateProjectList()
+		selectListItem(&m.projectList, func(item list.Item) bool {
+			p, ok := item.(projectItem)
+			return ok && p.project.ID == selectedID
+		})
+
+	case ViewSelectTask:
+		project := m.selectedProject
+		if m.editingEntry != nil {
+			project = m.editProject
+		}
+		if project == nil {
+			return
+		}
+		selectedID := 0
+		if item, ok := m.taskList.SelectedItem().(taskItem); ok {
+			selectedID = item.task.ID
+		}
+		for _, pwt := range projects {
+			if pwt.Project.ID == project.ID {
+				m.updateTaskList(pwt.Tasks)
+				break
+			}
+		}
+		selectListItem(&m.taskList, func(item list.Item) bool {
+			t, ok := item.(taskItem)
+			return ok && t.task.ID == selectedID
+		})
+	}
+}
+
+// selectListItem moves the cursor to the first item matching match, leaving
+// it in place when none does.
+func selectListItem(l *list.Model, match func(item list.Item) bool) {
+	for i, item := range l.Items() {
+		if match(item) {
+			l.Select(i)
+			return
+		}
+	}
+}
+
+// saveProjectCacheCmd writes projects to the on-disk cache in the background.
+// A failed write is ignored: it only costs the next startup its head start.
+func saveProjectCacheCmd(accountID string, projects []harvest.ProjectWithTasks) tea.Cmd {
+	return func() tea.Msg {
+		_ = state.SaveProjectCache(accountID, projects)
+		return nil
+	}
+}
+
+// projectsRefreshedStatus describes a completed manual refresh.
+func projectsRefreshedStatus(count int) string {
+	if count == 1 {
+		return "Projects refreshed (1 project)"
+	}
+	return fmt.Sprintf("Projects refreshed (%d projects)", count)
+}
@@ -5,6 +5,7 @@ import (
 	"time"
 
 	"github.com/charmbracelet/lipgloss"
+	"github.com/planetargon/harvest-tui/internal/duration"
 	"github.com/planetargon/harvest-tui/internal/harvest"
 )
 
@@ -17,20 +18,30 @@ func (m Model) shellWidth() int {
 	return width
 }
 
-// renderTitleBar renders the title bar with date navigation.
+// renderTitleBar renders the title bar with date navigation and, while requests
+// are waiting on the client-side rate limit, a throttled indicator.
 func (m Model) renderTitleBar() string {
 	width := m.shellWidth()
 
-	dateStr := m.currentDate.Format("Mon, Jan 2, 2006")
+	dateStr := m.currentDate.Format(m.titleDateLayout())
 	dateNav := ArrowNavStyle.Render("◀ ") + DateStyle.Render(dateStr) + ArrowNavStyle.Render(" ▶")
 
 	titleText := "  " + TitleStyle.Render("🌾 Harvest Time Tracker")
 	titleSuffix := dateNav + "  "
+	if m.throttled {
+		titleSuffix = WarningText.Render("⏳ throttled") + "  " + titleSuffix
+	}
 	spacerWidth := width - 2 - lipgloss.Width(titleText) - lipgloss.Width(titleSuffix)
 	if spacerWidth < 1 {
 		spacerWidth = 1
 	}
-	return titleText + strings.Repeat(" ", spacerWidth) + titleSuffix
+	titleBar := titleText + strings.Repeat(" ", spacerWidth) + titleSuffix
+
+	// A running timer stays in view whichever day or screen is shown
+	if badge := m.renderRunningBadge(); badge != "" {
+		titleBar += "\n" + badge
+	}
+	return titleBar
 }
 
 // buildShellBox wraps content in a styled box border with parameterized footer keybindings.
@@ -119,7 +130,7 @@ func (m Model) renderStyledListView() string {
 			totalHours += entry.Hours
 		}
 	}
-	totalStr := formatHoursSimple(totalHours)
+	totalStr := m.formatHours(totalHours)
 
 	// Section header with Tokyo Night styling
 	// Check if currentDate is today
@@ -233,8 +244,22 @@ func (m Model) renderStyledTimeEntry(entry harvest.TimeEntry, isSelected bool, m
 		displayHours += elapsed
 	}
 
+	// Billable entries get a green $, non-billable ones a dimmed one
+	billingStyle := NonBillableIcon
+	if entry.IsBillable {
+		billingStyle = BillableIcon
+	}
+
+	// Timestamp-based accounts show when the entry started and ended
+	timeLabel := ""
+	if r, ok := entryTimeRange(entry); ok {
+		timeLabel = m.formatTimeRange(r) + "  "
+	} else if start, ok := duration.ParseClock(entry.StartedTime); ok && entry.IsRunning {
+		timeLabel = m.formatClockTime(start) + "–  "
+	}
+
 	// Build styled components with optional selected background
-	var entryPath, styledDuration, indicator string
+	var entryPath, styledDuration, indicator, billing string
 	if isSelected {
 		bg := selectedBg
 		entryPath = ClientStyle.Background(bg).Render(clientName) +
@@ -242,11 +267,13 @@ func (m Model) renderStyledTimeEntry(entry harvest.TimeEntry, isSelected bool, m
 			ProjectStyle.Background(bg).Render(projectName) +
 			ArrowStyle.Background(bg).Render(" → ") +
 			TaskStyle.Background(bg).Render(taskName)
+		billing = MutedText.Background(bg).Render(timeLabel) +
+			billingStyle.Background(bg).Render("$") + lipgloss.NewStyle().Background(bg).Render(" ")
 
 		if entry.IsRunning {
-			styledDuration = RunningDurationStyle.Background(bg).Render(formatHoursSimple(displayHours))
+			styledDuration = RunningDurationStyle.Background(bg).Render(m.formatHours(displayHours))
 		} else {
-			styledDuration = DurationStyle.Background(bg).Render(formatHoursSimple(displayHours))
+			styledDuration = DurationStyle.Background(bg).Render(m.formatHours(displayHours))
 		}
 
 		if entry.IsRunning {
@@ -256,13 +283,14 @@ func (m Model) renderStyledTimeEntry(entry harvest.TimeEntry, isSelected bool, m
 		}
 	} else {
 		entryPath = RenderEntryPath(clientName, projectName, taskName)
+		billing = MutedText.Render(timeLabel) + billingStyle.Render("$") + " "
 
 		if entry.IsRunning {
-			styledDuration = RunningDurationStyle.Render(formatHoursSimple(displayHours))
+			styledDuration = RunningDurationStyle.Render(m.formatHours(displayHours))
 		} else if entry.IsLocked {
-			styledDuration = DurationStyle.Copy().Foreground(mutedText).Render(formatHoursSimple(displayHours))
+			styledDuration = DurationStyle.Copy().Foreground(mutedText).Render(m.formatHours(displayHours))
 		} else {
-			styledDuration = DurationStyle.Render(formatHoursSimple(displayHours))
+			styledDuration = DurationStyle.Render(m.formatHours(displayHours))
 		}
 
 		if entry.IsRunning {
@@ -274,7 +302,7 @@ func (m Model) renderStyledTimeEntry(entry harvest.TimeEntry, isSelected bool, m
 
 	// Calculate padding for alignment
 	pathWidth := lipgloss.Width(entryPath)
-	durationWidth := lipgloss.Width(styledDuration)
+	durationWidth := lipgloss.Width(billing + styledDuration)
 	indicatorWidth := lipgloss.Width(indicator)
 	padding := maxWidth - pathWidth - durationWidth - indicatorWidth - 4
 	if padding < 1 {
@@ -286,11 +314,11 @@ func (m Model) renderStyledTimeEntry(entry harvest.TimeEntry, isSelected bool, m
 	if isSelected {
 		// Selected entry with accent bar and full-width background
 		bgSpacer := lipgloss.NewStyle().Background(selectedBg).Render(strings.Repeat(" ", padding))
-		entryContent := entryPath + bgSpacer + styledDuration + indicator
+		entryContent := entryPath + bgSpacer + billing + styledDuration + indicator
 		entryLine = SelectedEntry.Width(maxWidth).Render(entryContent)
 	} else {
 		// Unselected entry with left padding
-		entryContent := entryPath + strings.Repeat(" ", padding) + styledDuration + indicator
+		entryContent := entryPath + strings.Repeat(" ", padding) + billing + styledDuration + indicator
 		if entry.IsLocked {
 			entryContent = LockedEntryStyle.Render(entryContent)
 		}
@@ -9,12 +9,13 @@ import (
 	"github.com/charmbracelet/lipgloss"
 	"github.com/planetargon/harvest-tui/internal/config"
 	"github.com/planetargon/harvest-tui/internal/harvest"
+	"github.com/planetargon/harvest-tui/internal/harvest/harvestfake"
 	"github.com/planetargon/harvest-tui/internal/state"
 )
 
 func newTestModel() Model {
 	cfg := &config.Config{Harvest: config.HarvestConfig{AccountID: "12345", AccessToken: "test-token"}}
-	client := harvest.NewClient("12345", "test-token")
+	client := harvestfake.New()
 	appState := &state.State{Recents: []state.RecentEntry{}}
 	m := NewModel(cfg, client, appState, &harvest.User{FirstName: "Test", LastName: "User"})
 	m.currentView = ViewList
@@ -312,6 +313,47 @@ func TestStatusMessageAutoClearing(t *testing.T) {
 	})
 }
 
+func TestTickLoop(t *testing.T) {
+	running := &harvest.TimeEntry{ID: 1, SpentDate: "2025-01-15", Hours: 1, IsRunning: true}
+
+	t.Run("given tick loop running when running timer fetched then does not start another", func(t *testing.T) {
+		model := newTestModel()
+
+		updatedModel, cmd := model.Update(runningEntryFetchedMsg{entry: running})
+		m := updatedModel.(Model)
+
+		if cmd != nil {
+			t.Error("expected no second tick loop")
+		}
+		if !m.ticking {
+			t.Error("expected the loop to keep running")
+		}
+	})
+
+	t.Run("given stopped tick loop when running timer fetched then starts it", func(t *testing.T) {
+		model := newTestModel()
+		model.ticking = false
+
+		updatedModel, cmd := model.Update(runningEntryFetchedMsg{entry: running})
+		m := updatedModel.(Model)
+
+		if cmd == nil || !m.ticking {
+			t.Error("expected the tick loop to start")
+		}
+	})
+
+	t.Run("given nothing to update when tick received then stops the loop", func(t *testing.T) {
+		model := newTestModel()
+
+		updatedModel, cmd := model.Update(tickMsg(time.Now()))
+		m := updatedModel.(Model)
+
+		if cmd != nil || m.ticking {
+			t.Error("expected the tick loop to stop")
+		}
+	})
+}
+
 func TestStatusMessageStyling(t *testing.T) {
 	t.Run("given success status message when rendered then appears in output", func(t *testing.T) {
 		model := newTestModel()
@@ -374,6 +416,8 @@ func TestHelpViewRendering(t *testing.T) {
 			"Edit entry",
 			"Delete entry",
 			"Start/stop timer",
+			"Weekly Timesheet",
+			"Previous/next week",
 			"General",
 			"Toggle this help",
 			"Quit/Go back",
@@ -442,26 +486,26 @@ func TestConfirmDeleteViewRendering(t *testing.T) {
 }
 
 func TestEditFormTabNavigation(t *testing.T) {
-	t.Run("given edit view on task field when tab pressed then moves to notes field", func(t *testing.T) {
+	t.Run("given edit view on task field when tab pressed then moves to date field", func(t *testing.T) {
 		model := newTestModel()
 		model.currentView = ViewEditEntry
 		model.editingEntry = &harvest.TimeEntry{ID: 1, Hours: 1.5, Notes: "Test"}
-		model.editCurrentField = 0
+		model.editCurrentField = 1
 
 		msg := tea.KeyMsg{Type: tea.KeyTab}
 		updatedModel, _ := model.Update(msg)
 		m := updatedModel.(Model)
 
-		if m.editCurrentField != 1 {
-			t.Errorf("expected editCurrentField to be 1 after tab, got %d", m.editCurrentField)
+		if m.editCurrentField != 2 {
+			t.Errorf("expected editCurrentField to be 2 after tab, got %d", m.editCurrentField)
 		}
 	})
 
-	t.Run("given edit view on duration field when tab pressed then wraps to task field", func(t *testing.T) {
+	t.Run("given edit view on billable field when tab pressed then wraps to project field", func(t *testing.T) {
 		model := newTestModel()
 		model.currentView = ViewEditEntry
 		model.editingEntry = &harvest.TimeEntry{ID: 1, Hours: 1.5, Notes: "Test"}
-		model.editCurrentField = 2
+		model.editCurrentField = 5
 
 		msg := tea.KeyMsg{Type: tea.KeyTab}
 		updatedModel, _ := model.Update(msg)
@@ -472,7 +516,7 @@ func TestEditFormTabNavigation(t *testing.T) {
 		}
 	})
 
-	t.Run("given edit view on task field when shift+tab pressed then wraps to duration field", func(t *testing.T) {
+	t.Run("given edit view on project field when shift+tab pressed then wraps to billable field", func(t *testing.T) {
 		model := newTestModel()
 		model.currentView = ViewEditEntry
 		model.editingEntry = &harvest.TimeEntry{ID: 1, Hours: 1.5, Notes: "Test"}
@@ -482,8 +526,8 @@ func TestEditFormTabNavigation(t *testing.T) {
 		updatedModel, _ := model.Update(msg)
 		m := updatedModel.(Model)
 
-		if m.editCurrentField != 2 {
-			t.Errorf("expected editCurrentField to wrap to 2 on shift+tab, got %d", m.editCurrentField)
+		if m.editCurrentField != 5 {
+			t.Errorf("expected editCurrentField to wrap to 5 on shift+tab, got %d", m.editCurrentField)
 		}
 	})
 }
@@ -494,7 +538,7 @@ func TestNewEntryFormTabNavigation(t *testing.T) {
 		model.currentView = ViewNewEntry
 		model.newEntryCurrentField = 0
 
-		expectedFields := []int{1, 2, 3, 0} // Tab cycles: 0->1->2->3->0
+		expectedFields := []int{1, 2, 3, 4, 0} // Tab cycles: 0->1->2->3->4->0
 		for _, expected := range expectedFields {
 			msg := tea.KeyMsg{Type: tea.KeyTab}
 			updatedModel, _ := model.Update(msg)
@@ -833,7 +877,7 @@ func TestTimeEntryFetchedMessage(t *testing.T) {
 			{ID: 2, Hours: 2.0, Notes: "Entry 2"},
 		}
 
-		msg := timeEntriesFetchedMsg{entries: entries}
+		msg := timeEntriesFetchedMsg{date: model.currentDate.Format("2006-01-02"), entries: entries}
 		updatedModel, _ := model.Update(msg)
 		m := updatedModel.(Model)
 
@@ -852,7 +896,7 @@ func TestTimeEntryFetchedMessage(t *testing.T) {
 		model := newTestModel()
 		model.loading = true
 
-		msg := timeEntriesFetchedMsg{err: errForTest("network error")}
+		msg := timeEntriesFetchedMsg{date: model.currentDate.Format("2006-01-02"), err: errForTest("network error")}
 		updatedModel, _ := model.Update(msg)
 		m := updatedModel.(Model)
 
@@ -0,0 +1,110 @@
+package tui
+
+import (
+	"context"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/planetargon/harvest-tui/internal/harvest"
+)
+
+// runningEntryFetchedMsg carries the timer running on any date, nil when none.
+type runningEntryFetchedMsg struct {
+	entry *harvest.TimeEntry
+	err   error
+}
+
+// fetchRunningEntryCmd looks for a running timer across all dates, so one
+// started on another day is not missed.
+func fetchRunningEntryCmd(client harvest.API) tea.Cmd {
+	return func() tea.Msg {
+		entry, err := client.FetchRunningTimeEntryContext(context.Background())
+		return runningEntryFetchedMsg{entry: entry, err: err}
+	}
+}
+
+// setRunningEntry records the timer running on any date, or clears it.
+func (m *Model) setRunningEntry(entry *harvest.TimeEntry) {
+	hadBadge := m.runningEntry != nil
+	m.runningEntry = entry
+	m.runningFetchTime = time.Now()
+	if hadBadge != (entry != nil) {
+		m.setListSizes()
+	}
+}
+
+// reconcileRunningEntry updates the running timer from the day's freshly
+// fetched entries, which also show a timer on this day being stopped.
+func (m *Model) reconcileRunningEntry() {
+	for _, entry := range m.timeEntries {
+		if entry.IsRunning {
+			running := entry
+			m.setRunningEntry(&running)
+			return
+		}
+	}
+	if m.runningEntry != nil && m.runningEntry.SpentDate == m.currentDate.Format("2006-01-02") {
+		m.setRunningEntry(nil)
+	}
+}
+
+// runningEntryHours returns the running timer's hours, counting the time
+// since it was fetched.
+func (m Model) runningEntryHours() float64 {
+	return m.runningEntry.Hours + time.Since(m.runningFetchTime).Hours()
+}
+
+// renderRunningBadge renders the header line for the running timer, with a
+// hint to jump to it when it is on another day. It is empty when no timer is
+// running.
+func (m Model) renderRunningBadge() string {
+	if m.runningEntry == nil {
+		return ""
+	}
+	entry := m.runningEntry
+
+	badge := "  " + RunningDot.Render("●") + " " +
+		ClientStyle.Render(truncateString(entry.Client.Name, 20)) +
+		ArrowStyle.Render(" → ") +
+		ProjectStyle.Render(truncateString(entry.Project.Name, 25)) +
+		"  " + AccentText.Render(m.formatHours(m.runningEntryHours()))
+
+	if entry.SpentDate != m.currentDate.Format("2006-01-02") {
+		label := entry.SpentDate
+		if date, err := time.ParseInLocation("2006-01-02", entry.SpentDate, time.Local); err == nil {
+			label = date.Format("Mon, " + m.shortDateLayout())
+		}
+		badge += MutedText.Render("  " + label + " · g to jump")
+	}
+	return badge
+}
+
+// jumpToRunningEntry shows the running timer's day with its entry selected.
+func (m Model) jumpToRunningEntry() (Model, tea.Cmd) {
+	if m.runningEntry == nil {
+		m.setStatusMessage("No timer is running")
+		return m, nil
+	}
+	entry := m.runningEntry
+
+	if entry.SpentDate == m.currentDate.Format("2006-01-02") {
+		for i, e := range m.timeEntries {
+			if e.ID == entry.ID {
+				m.selectedEntryIndex = i
+				break
+			}
+		}
+		return m, nil
+	}
+
+	date, err := time.ParseInLocation("2006-01-02", entry.SpentDate, time.Local)
+	if err != nil {
+		return m, nil
+	}
+	m.currentDate = date
+	m.selectedEntryIndex = 0
+	m.selectEntryID = entry.ID
+	m.loading = true
+	m.clearStatusMessage()
+	return m, fetchTimeEntriesCmd(m.newTimeEntriesFetchContext(), m.harvestClient, m.currentDate)
+}
@@ -0,0 +1,98 @@
+package tui
+
+import (
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/planetargon/harvest-tui/internal/harvest"
+)
+
+func TestRunningTimerOnOtherDays(t *testing.T) {
+	yesterdaysTimer := harvest.TimeEntry{
+		ID:        7,
+		SpentDate: "2025-01-14",
+		Hours:     3.5,
+		IsRunning: true,
+		Client:    harvest.TimeEntryClient{ID: 1, Name: "Acme"},
+		Project:   harvest.TimeEntryProject{ID: 10, Name: "Website"},
+		Task:      harvest.TimeEntryTask{ID: 100, Name: "Development"},
+	}
+
+	t.Run("given timer running yesterday when found at startup then header shows a badge with a jump hint", func(t *testing.T) {
+		m, fake := newFakeModel(t)
+		fake.AddTimeEntry(yesterdaysTimer)
+
+		m = runCmd(t, m, fetchRunningEntryCmd(m.harvestClient))
+
+		if !m.hasRunningTimer() {
+			t.Error("expected the timer to count as running while browsing another day")
+		}
+		view := m.View()
+		if !strings.Contains(view, "● Acme → Website  3:30") {
+			t.Errorf("expected running badge, got:\n%s", view)
+		}
+		if !strings.Contains(view, "Tue, Jan 14 · g to jump") {
+			t.Error("expected jump hint with the timer's date")
+		}
+	})
+
+	t.Run("given timer running yesterday when g pressed then shows its day with it selected", func(t *testing.T) {
+		m, fake := newFakeModel(t)
+		fake.AddTimeEntry(harvest.TimeEntry{SpentDate: "2025-01-14", Hours: 1, Notes: "Earlier"})
+		fake.AddTimeEntry(yesterdaysTimer)
+		fake.AddTimeEntry(harvest.TimeEntry{SpentDate: "2025-01-14", Hours: 1, Notes: "Later"})
+		m = runCmd(t, m, fetchRunningEntryCmd(m.harvestClient))
+
+		newModel, cmd := m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("g")})
+		m = runCmd(t, newModel.(Model), cmd)
+
+		if got := m.currentDate.Format("2006-01-02"); got != "2025-01-14" {
+			t.Fatalf("expected to jump to 2025-01-14, got %s", got)
+		}
+		if m.timeEntries[m.selectedEntryIndex].ID != 7 {
+			t.Errorf("expected running entry selected, got %+v", m.timeEntries[m.selectedEntryIndex])
+		}
+		if strings.Contains(m.View(), "g to jump") {
+			t.Error("expected no jump hint once on the timer's day")
+		}
+	})
+
+	t.Run("given no running timer when g pressed then says so", func(t *testing.T) {
+		m, _ := newFakeModel(t)
+
+		newModel, _ := m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("g")})
+
+		if got := newModel.(Model).statusMessage; got != "No timer is running" {
+			t.Errorf("expected no timer status, got '%s'", got)
+		}
+	})
+
+	t.Run("given running timer when stopped then badge is removed", func(t *testing.T) {
+		m, fake := newFakeModel(t)
+		fake.AddTimeEntry(yesterdaysTimer)
+		m = runCmd(t, m, fetchRunningEntryCmd(m.harvestClient))
+
+		m = runCmd(t, m, stopTimeEntryCmd(m.harvestClient, 7))
+
+		if m.runningEntry != nil || strings.Contains(m.View(), "● Acme → Website") {
+			t.Error("expected badge removed after stopping")
+		}
+	})
+
+	t.Run("given timer stopped elsewhere when its day is refetched then badge is removed", func(t *testing.T) {
+		m, fake := newFakeModel(t)
+		timer := fake.AddTimeEntry(harvest.TimeEntry{SpentDate: "2025-01-15", Hours: 1, IsRunning: true})
+		m = runCmd(t, m, fetchRunningEntryCmd(m.harvestClient))
+		if m.runningEntry == nil {
+			t.Fatal("expected today's timer to be found")
+		}
+		fake.StopTimeEntryContext(t.Context(), timer.ID)
+
+		m = runCmd(t, m, fetchTimeEntriesCmd(m.newTimeEntriesFetchContext(), m.harvestClient, m.currentDate))
+
+		if m.runningEntry != nil {
+			t.Error("expected badge removed once the day shows the timer stopped")
+		}
+	})
+}
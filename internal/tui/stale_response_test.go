@@ -0,0 +1,99 @@
+package tui
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/planetargon/harvest-tui/internal/harvest"
+	"github.com/planetargon/harvest-tui/internal/harvest/harvestfake"
+)
+
+func TestStaleTimeEntriesResponses(t *testing.T) {
+	monday := []harvest.TimeEntry{{ID: 1, SpentDate: "2025-01-13", Notes: "Monday entry"}}
+	tuesday := []harvest.TimeEntry{{ID: 2, SpentDate: "2025-01-14", Notes: "Tuesday entry"}}
+
+	t.Run("given navigation to tuesday when monday response arrives late then keeps tuesday entries", func(t *testing.T) {
+		model := newTestModel()
+		model.currentDate = time.Date(2025, 1, 13, 0, 0, 0, 0, time.UTC)
+
+		// Navigate Monday -> Tuesday while Monday's fetch is still in flight
+		newModel, _ := model.handleListViewKeys(tea.KeyMsg{Type: tea.KeyRight})
+		m := newModel.(Model)
+
+		// Tuesday's response arrives first
+		newModel, _ = m.Update(timeEntriesFetchedMsg{date: "2025-01-14", entries: tuesday})
+		m = newModel.(Model)
+
+		// Monday's slow response arrives afterwards
+		newModel, _ = m.Update(timeEntriesFetchedMsg{date: "2025-01-13", entries: monday})
+		m = newModel.(Model)
+
+		if len(m.timeEntries) != 1 || m.timeEntries[0].ID != 2 {
+			t.Errorf("expected tuesday entries to remain, got %+v", m.timeEntries)
+		}
+	})
+
+	t.Run("given navigation to tuesday when monday response arrives first then stays loading for tuesday", func(t *testing.T) {
+		model := newTestModel()
+		model.currentDate = time.Date(2025, 1, 13, 0, 0, 0, 0, time.UTC)
+		model.timeEntries = monday
+
+		newModel, _ := model.handleListViewKeys(tea.KeyMsg{Type: tea.KeyRight})
+		m := newModel.(Model)
+
+		newModel, _ = m.Update(timeEntriesFetchedMsg{date: "2025-01-13", entries: monday})
+		m = newModel.(Model)
+
+		if !m.loading {
+			t.Error("expected loading to remain true until tuesday's response arrives")
+		}
+
+		newModel, _ = m.Update(timeEntriesFetchedMsg{date: "2025-01-14", entries: tuesday})
+		m = newModel.(Model)
+
+		if m.loading {
+			t.Error("expected loading to be false once tuesday's response arrives")
+		}
+		if len(m.timeEntries) != 1 || m.timeEntries[0].ID != 2 {
+			t.Errorf("expected tuesday entries, got %+v", m.timeEntries)
+		}
+	})
+
+	t.Run("given stale error response when received then does not show an error for the current day", func(t *testing.T) {
+		model := newTestModel()
+		model.currentDate = time.Date(2025, 1, 14, 0, 0, 0, 0, time.UTC)
+		model.timeEntries = tuesday
+
+		newModel, _ := model.Update(timeEntriesFetchedMsg{date: "2025-01-13", err: errForTest("network error")})
+		m := newModel.(Model)
+
+		if m.errorMessage != "" {
+			t.Errorf("expected no error message, got %q", m.errorMessage)
+		}
+		if len(m.timeEntries) != 1 {
+			t.Errorf("expected current entries to be untouched, got %d", len(m.timeEntries))
+		}
+	})
+
+	t.Run("given fetch command when executed then tags message with the requested date", func(t *testing.T) {
+		date := time.Date(2025, 1, 14, 15, 0, 0, 0, time.UTC)
+		cmd := fetchTimeEntriesCmd(cancelledContext(), harvestfake.New(), date)
+
+		msg, ok := cmd().(timeEntriesFetchedMsg)
+		if !ok {
+			t.Fatal("expected timeEntriesFetchedMsg")
+		}
+		if msg.date != "2025-01-14" {
+			t.Errorf("expected date 2025-01-14, got %s", msg.date)
+		}
+	})
+}
+
+// cancelledContext returns a context that is already cancelled, so commands return without touching the network.
+func cancelledContext() context.Context {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	return ctx
+}
@@ -92,6 +92,12 @@ var (
 	LockedIcon = lipgloss.NewStyle().
 			Foreground(yellowColor)
 
+	BillableIcon = lipgloss.NewStyle().
+			Foreground(greenColor)
+
+	NonBillableIcon = lipgloss.NewStyle().
+			Foreground(dimText)
+
 	LockedEntryStyle = lipgloss.NewStyle().
 				Foreground(mutedText)
 
@@ -144,6 +150,29 @@ var (
 	DividerStyle = lipgloss.NewStyle().
 			Foreground(borderColor)
 
+	// Week grid styles
+	WeekCellStyle = lipgloss.NewStyle().
+			Foreground(primaryText).
+			Width(weekCellWidth).
+			Align(lipgloss.Right)
+
+	WeekEmptyCellStyle = WeekCellStyle.
+				Foreground(dimText)
+
+	WeekRunningCellStyle = WeekCellStyle.
+				Foreground(accentColor).
+				Bold(true)
+
+	WeekHeaderStyle = WeekCellStyle.
+			Foreground(mutedText)
+
+	WeekTodayHeaderStyle = WeekCellStyle.
+				Foreground(accentColor).
+				Bold(true)
+
+	WeekTotalCellStyle = WeekCellStyle.
+				Foreground(accentColor)
+
 	// Empty state
 	EmptyState = lipgloss.NewStyle().
 			Foreground(mutedText).
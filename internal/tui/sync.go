@@ -0,0 +1,150 @@
+package tui
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/planetargon/harvest-tui/internal/harvest"
+)
+
+// syncOverlap is how far before the last fetch incremental syncs start, so
+// entries updated while it was in flight, or hidden by clock skew between
+// here and Harvest, are not missed. Merging an entry twice is harmless.
+const syncOverlap = time.Minute
+
+// fullRefreshInterval is how often refreshes fetch the whole range instead of
+// just its changes, dropping entries deleted or moved away elsewhere, which
+// Harvest's changes leave out.
+const fullRefreshInterval = 5 * time.Minute
+
+// timeEntriesSyncedMsg carries the changes to the day shown in the list since
+// its entries were last fetched.
+type timeEntriesSyncedMsg struct {
+	date      string
+	changes   *harvest.TimeEntryChanges
+	fetchedAt time.Time
+	err       error
+}
+
+// weekEntriesSyncedMsg carries the changes to the week starting at weekStart
+// since its entries were last fetched.
+type weekEntriesSyncedMsg struct {
+	weekStart time.Time
+	changes   *harvest.TimeEntryChanges
+	fetchedAt time.Time
+	err       error
+}
+
+// syncTimeEntriesCmd fetches the entries on date changed since the given time.
+func syncTimeEntriesCmd(ctx context.Context, client harvest.API, date time.Time, since time.Time) tea.Cmd {
+	return func() tea.Msg {
+		dateStr := date.Format("2006-01-02")
+		fetchedAt := time.Now()
+		changes, err := client.FetchTimeEntryChangesContext(ctx, dateStr, dateStr, since)
+		return timeEntriesSyncedMsg{date: dateStr, changes: changes, fetchedAt: fetchedAt, err: err}
+	}
+}
+
+// syncWeekEntriesCmd fetches the entries in the week starting at weekStart
+// changed since the given time.
+func syncWeekEntriesCmd(ctx context.Context, client harvest.API, weekStart time.Time, since time.Time) tea.Cmd {
+	return func() tea.Msg {
+		from := weekStart.Format("2006-01-02")
+		to := weekStart.AddDate(0, 0, daysInWeek-1).Format("2006-01-02")
+		fetchedAt := time.Now()
+		changes, err := client.FetchTimeEntryChangesContext(ctx, from, to, since)
+		return weekEntriesSyncedMsg{weekStart: weekStart, changes: changes, fetchedAt: fetchedAt, err: err}
+	}
+}
+
+// refreshTimeEntriesCmd brings the day's entries up to date, syncing just the
+// changes when they were fetched in full recently and fetching them all
+// otherwise.
+func (m *Model) refreshTimeEntriesCmd() tea.Cmd {
+	if m.loading || m.entriesSyncedAt.IsZero() || time.Since(m.entriesLoadedAt) >= fullRefreshInterval {
+		return fetchTimeEntriesCmd(m.newTimeEntriesFetchContext(), m.harvestClient, m.currentDate)
+	}
+	return syncTimeEntriesCmd(m.newTimeEntriesFetchContext(), m.harvestClient, m.currentDate, m.entriesSyncedAt.Add(-syncOverlap))
+}
+
+// refreshWeekEntriesCmd is refreshTimeEntriesCmd for the weekly timesheet.
+func (m *Model) refreshWeekEntriesCmd() tea.Cmd {
+	if m.weekLoading || m.weekSyncedAt.IsZero() || time.Since(m.weekLoadedAt) >= fullRefreshInterval {
+		return fetchWeekEntriesCmd(m.newWeekFetchContext(), m.harvestClient, m.weekStart)
+	}
+	return syncWeekEntriesCmd(m.newWeekFetchContext(), m.harvestClient, m.weekStart, m.weekSyncedAt.Add(-syncOverlap))
+}
+
+// applyTimeEntriesSync merges synced changes into the day's entries, keeping
+// the same entry selected.
+func (m *Model) applyTimeEntriesSync(msg timeEntriesSyncedMsg) tea.Cmd {
+	// Drop syncs superseded by a newer fetch or for a day no longer shown
+	if errors.Is(msg.err, context.Canceled) || msg.date != m.currentDate.Format("2006-01-02") {
+		return nil
+	}
+	if msg.err != nil {
+		m.errorMessage = "Failed to fetch time entries: " + describeError(msg.err)
+		return nil
+	}
+
+	// Unchanged running timers are left out of the changes, so bring their
+	// hours up to now before the live display restarts from lastFetchTime
+	merged := msg.changes.Merge(accrueRunningHours(m.timeEntries, m.lastFetchTime))
+
+	selectedID := 0
+	if m.selectedEntryIndex < len(m.timeEntries) {
+		selectedID = m.timeEntries[m.selectedEntryIndex].ID
+	}
+	m.timeEntries = merged
+	for i, entry := range merged {
+		if entry.ID == selectedID {
+			m.selectedEntryIndex = i
+			break
+		}
+	}
+	m.errorMessage = ""
+	m.lastFetchTime = time.Now()
+	m.entriesSyncedAt = msg.fetchedAt
+	m.reconcileRunningEntry()
+	return nil
+}
+
+// applyWeekSync is applyTimeEntriesSync for the weekly timesheet.
+func (m *Model) applyWeekSync(msg weekEntriesSyncedMsg) tea.Cmd {
+	if errors.Is(msg.err, context.Canceled) || !msg.weekStart.Equal(m.weekStart) {
+		return nil
+	}
+	if msg.err != nil {
+		m.errorMessage = "Failed to fetch time entries: " + describeError(msg.err)
+		return nil
+	}
+
+	merged := msg.changes.Merge(accrueRunningHours(m.weekEntries, m.weekFetchTime))
+
+	m.weekEntries = merged
+	if rowCount := len(m.weekRows()); m.weekRowIndex >= rowCount {
+		m.weekRowIndex = max(rowCount-1, 0)
+	}
+	m.errorMessage = ""
+	m.weekFetchTime = time.Now()
+	m.weekSyncedAt = msg.fetchedAt
+	return nil
+}
+
+// accrueRunningHours returns a copy of entries with the time since fetchedAt
+// added to running timers, whose hours Harvest computed as of that fetch.
+func accrueRunningHours(entries []harvest.TimeEntry, fetchedAt time.Time) []harvest.TimeEntry {
+	accrued := append([]harvest.TimeEntry(nil), entries...)
+	if fetchedAt.IsZero() {
+		return accrued
+	}
+	elapsed := time.Since(fetchedAt).Hours()
+	for i := range accrued {
+		if accrued[i].IsRunning {
+			accrued[i].Hours += elapsed
+		}
+	}
+	return accrued
+}
@@ -0,0 +1,175 @@
+package tui
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/planetargon/harvest-tui/internal/harvest"
+	"github.com/planetargon/harvest-tui/internal/harvest/harvestfake"
+)
+
+// newSyncedModel returns a fake-backed list model whose day has been fetched
+// once, with two entries last updated an hour ago.
+func newSyncedModel(t *testing.T) (Model, *harvestfake.Client) {
+	t.Helper()
+	m, fake := newFakeModel(t)
+	hourAgo := time.Now().Add(-time.Hour)
+	fake.AddTimeEntry(harvest.TimeEntry{ID: 1, SpentDate: "2025-01-15", Hours: 1, Notes: "Standup", UpdatedAt: hourAgo})
+	fake.AddTimeEntry(harvest.TimeEntry{ID: 2, SpentDate: "2025-01-15", Hours: 2, Notes: "Review", UpdatedAt: hourAgo})
+
+	m = runCmd(t, m, fetchTimeEntriesCmd(m.newTimeEntriesFetchContext(), m.harvestClient, m.currentDate))
+	if m.entriesSyncedAt.IsZero() {
+		t.Fatal("expected the full fetch to record its time")
+	}
+	return m, fake
+}
+
+// entryRequestsAPI counts the requests for time entries.
+type entryRequestsAPI struct {
+	*harvestfake.Client
+	requests int
+}
+
+func (a *entryRequestsAPI) FetchTimeEntriesRangeContext(ctx context.Context, from, to string) ([]harvest.TimeEntry, error) {
+	a.requests++
+	return a.Client.FetchTimeEntriesRangeContext(ctx, from, to)
+}
+
+func (a *entryRequestsAPI) FetchTimeEntriesContext(ctx context.Context, date string) ([]harvest.TimeEntry, error) {
+	a.requests++
+	return a.Client.FetchTimeEntriesContext(ctx, date)
+}
+
+func (a *entryRequestsAPI) FetchTimeEntryChangesContext(ctx context.Context, from, to string, since time.Time) (*harvest.TimeEntryChanges, error) {
+	a.requests++
+	return a.Client.FetchTimeEntryChangesContext(ctx, from, to, since)
+}
+
+func TestIncrementalSync(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("given fetched day when refreshed then syncs the edited entry and keeps the selection", func(t *testing.T) {
+		m, fake := newSyncedModel(t)
+		m.selectedEntryIndex = 1 // Standup, the older entry
+		notes := "Standup (ran long)"
+		fake.UpdateTimeEntryContext(ctx, 1, harvest.UpdateTimeEntryRequest{Notes: &notes})
+
+		msg := m.refreshTimeEntriesCmd()()
+		if _, ok := msg.(timeEntriesSyncedMsg); !ok {
+			t.Fatalf("expected an incremental sync, got %T", msg)
+		}
+		newModel, cmd := m.Update(msg)
+		m = newModel.(Model)
+
+		if cmd != nil {
+			t.Error("expected no full refetch")
+		}
+		if len(m.timeEntries) != 2 || m.timeEntries[1].Notes != "Standup (ran long)" {
+			t.Errorf("expected edited entry merged in place, got %+v", m.timeEntries)
+		}
+		if m.selectedEntryIndex != 1 {
+			t.Errorf("expected selection to stay on entry 1, got index %d", m.selectedEntryIndex)
+		}
+	})
+
+	t.Run("given entry added elsewhere when synced then it appears first", func(t *testing.T) {
+		m, fake := newSyncedModel(t)
+		fake.AddTimeEntry(harvest.TimeEntry{ID: 3, SpentDate: "2025-01-15", Hours: 0.5, Notes: "Hotfix"})
+
+		m = runCmd(t, m, m.refreshTimeEntriesCmd())
+
+		if len(m.timeEntries) != 3 || m.timeEntries[0].Notes != "Hotfix" {
+			t.Errorf("expected new entry first, got %+v", m.timeEntries)
+		}
+	})
+
+	t.Run("given entry deleted elsewhere when the full refresh is due then refetches the day without it", func(t *testing.T) {
+		m, fake := newSyncedModel(t)
+		fake.DeleteTimeEntryContext(ctx, 1)
+
+		m = runCmd(t, m, m.refreshTimeEntriesCmd())
+		if len(m.timeEntries) != 2 {
+			t.Fatalf("expected the sync to keep the deleted entry until the full refresh, got %+v", m.timeEntries)
+		}
+
+		m.entriesLoadedAt = time.Now().Add(-fullRefreshInterval)
+		msg := m.refreshTimeEntriesCmd()()
+		if _, ok := msg.(timeEntriesFetchedMsg); !ok {
+			t.Fatalf("expected a full refetch, got %T", msg)
+		}
+		newModel, _ := m.Update(msg)
+		m = newModel.(Model)
+
+		if len(m.timeEntries) != 1 || m.timeEntries[0].ID != 2 {
+			t.Errorf("expected only entry 2 left, got %+v", m.timeEntries)
+		}
+		if time.Since(m.entriesLoadedAt) > time.Minute {
+			t.Error("expected the refetch to record its time for the next full refresh")
+		}
+	})
+
+	t.Run("given fetched day and week when refreshed then each refresh makes one request", func(t *testing.T) {
+		m, fake := newSyncedModel(t)
+		m, cmd := m.openWeekView()
+		m = runCmd(t, m, cmd)
+		api := &entryRequestsAPI{Client: fake}
+		m.harvestClient = api
+
+		m = runCmd(t, m, m.refreshTimeEntriesCmd())
+		m = runCmd(t, m, m.refreshWeekEntriesCmd())
+
+		if api.requests != 2 {
+			t.Errorf("expected one request per refresh, got %d", api.requests)
+		}
+	})
+
+	t.Run("given sync for a day no longer shown when received then it is ignored", func(t *testing.T) {
+		m, fake := newSyncedModel(t)
+		notes := "Edited"
+		fake.UpdateTimeEntryContext(ctx, 1, harvest.UpdateTimeEntryRequest{Notes: &notes})
+		msg := m.refreshTimeEntriesCmd()()
+		m.currentDate = m.currentDate.AddDate(0, 0, 1)
+
+		newModel, _ := m.Update(msg)
+
+		for _, entry := range newModel.(Model).timeEntries {
+			if entry.Notes == "Edited" {
+				t.Error("expected stale sync to be dropped")
+			}
+		}
+	})
+
+	t.Run("given running timer unchanged when synced then its hours keep counting", func(t *testing.T) {
+		m, _ := newSyncedModel(t)
+		m.timeEntries[0].IsRunning = true
+		m.lastFetchTime = time.Now().Add(-30 * time.Minute)
+
+		m = runCmd(t, m, m.refreshTimeEntriesCmd())
+
+		if got := m.timeEntries[0].Hours; got < 2.49 || got > 2.51 {
+			t.Errorf("expected 2:30 after half an hour running, got %v", got)
+		}
+	})
+
+	t.Run("given week view when refreshed then syncs the week's changes", func(t *testing.T) {
+		m, fake := newSyncedModel(t)
+		m, cmd := m.openWeekView()
+		m = runCmd(t, m, cmd)
+		if m.weekSyncedAt.IsZero() {
+			t.Fatal("expected the week fetch to record its time")
+		}
+		fake.AddTimeEntry(harvest.TimeEntry{ID: 3, SpentDate: "2025-01-16", Hours: 0.5})
+
+		msg := m.refreshWeekEntriesCmd()()
+		if _, ok := msg.(weekEntriesSyncedMsg); !ok {
+			t.Fatalf("expected an incremental sync, got %T", msg)
+		}
+		newModel, _ := m.Update(msg)
+		m = newModel.(Model)
+
+		if len(m.weekEntries) != 3 || m.weekEntries[0].ID != 3 {
+			t.Errorf("expected the new entry merged first, got %+v", m.weekEntries)
+		}
+	})
+}
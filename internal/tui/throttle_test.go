@@ -0,0 +1,50 @@
+package tui
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/charmbracelet/lipgloss"
+)
+
+func TestThrottleIndicator(t *testing.T) {
+	t.Run("given throttle started when message received then title bar shows indicator", func(t *testing.T) {
+		model := newTestModel()
+
+		newModel, _ := model.Update(ThrottleMsg{Throttled: true})
+		m := newModel.(Model)
+
+		if !m.throttled {
+			t.Error("expected throttled to be true")
+		}
+		if !strings.Contains(m.View(), "throttled") {
+			t.Error("expected output to contain throttled indicator")
+		}
+	})
+
+	t.Run("given throttled model when throttle ends then indicator is removed", func(t *testing.T) {
+		model := newTestModel()
+		model.throttled = true
+
+		newModel, _ := model.Update(ThrottleMsg{Throttled: false})
+		m := newModel.(Model)
+
+		if m.throttled {
+			t.Error("expected throttled to be false")
+		}
+		if strings.Contains(m.View(), "throttled") {
+			t.Error("expected output not to contain throttled indicator")
+		}
+	})
+
+	t.Run("given throttled model when rendered then title bar fits the box", func(t *testing.T) {
+		model := newTestModel()
+		model.throttled = true
+
+		titleBar := model.renderTitleBar()
+
+		if width := lipgloss.Width(titleBar); width > model.shellWidth()-2 {
+			t.Errorf("expected title bar to fit within %d columns, got %d", model.shellWidth()-2, width)
+		}
+	})
+}
@@ -0,0 +1,130 @@
+package tui
+
+import (
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/planetargon/harvest-tui/internal/harvest"
+)
+
+func TestStartsTimer(t *testing.T) {
+	tests := []struct {
+		duration string
+		want     bool
+	}{
+		{"", true},
+		{"   ", true},
+		{"0:00", true},
+		{"00:00", true},
+		{"0:01", false},
+		{"1:30", false},
+		{"invalid", false},
+	}
+
+	for _, tt := range tests {
+		t.Run("given duration '"+tt.duration+"' when checked then reports whether it starts a timer", func(t *testing.T) {
+			if got := startsTimer(tt.duration); got != tt.want {
+				t.Errorf("expected %v, got %v", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestTimerFirstEntryCreation(t *testing.T) {
+	t.Run("given zero duration when entry created then a running timer replaces the previous one", func(t *testing.T) {
+		m, fake := newFakeModel(t)
+		previous := fake.AddTimeEntry(harvest.TimeEntry{SpentDate: "2025-01-15", Hours: 1, IsRunning: true})
+		m.timeEntries = []harvest.TimeEntry{previous}
+		m.selectedProject = &harvest.Project{ID: 10, Name: "Website", Client: harvest.ProjectClient{ID: 1, Name: "Acme"}}
+		m.selectedTask = &harvest.Task{ID: 100, Name: "Development"}
+		m.newEntryHours = "0:00"
+		m.currentView = ViewBillableToggle
+
+		newModel, cmd := m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+		m = newModel.(Model)
+		if cmd == nil {
+			t.Fatal("expected command to create entry, got nil")
+		}
+
+		newModel, cmd = m.Update(cmd())
+		m = newModel.(Model)
+
+		if m.statusMessage != "Timer started successfully" {
+			t.Errorf("expected timer started message, got '%s'", m.statusMessage)
+		}
+		if cmd == nil {
+			t.Fatal("expected refetch command after starting a timer")
+		}
+
+		m = runCmd(t, m, fetchTimeEntriesCmd(m.newTimeEntriesFetchContext(), m.harvestClient, m.currentDate))
+
+		running := 0
+		for _, entry := range m.timeEntries {
+			if entry.IsRunning {
+				running++
+				if entry.ID == previous.ID {
+					t.Error("expected previously running entry to be stopped")
+				}
+			}
+		}
+		if running != 1 {
+			t.Errorf("expected exactly one running timer, got %d", running)
+		}
+	})
+
+	t.Run("given empty duration in new entry form when saved then creates running entry", func(t *testing.T) {
+		m, fake := newFakeModel(t)
+		m = runCmd(t, m, fetchProjectsWithTasksCmd(m.harvestClient))
+		newModel, _ := m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("n")})
+		m = newModel.(Model)
+		m.selectedProject = &m.projectsWithTasks[0].Project
+		m.selectedTask = &m.projectsWithTasks[0].Tasks[0]
+		m.durationInput.SetValue("")
+
+		newModel, cmd := m.Update(tea.KeyMsg{Type: tea.KeyCtrlS})
+		m = newModel.(Model)
+		if cmd == nil {
+			t.Fatalf("expected create command, got status '%s'", m.statusMessage)
+		}
+		m = runCmd(t, m, cmd)
+
+		entries := fake.TimeEntries()
+		if len(entries) != 1 || !entries[0].IsRunning || entries[0].Hours != 0 {
+			t.Errorf("expected a single running entry, got %+v", entries)
+		}
+	})
+
+	t.Run("given explicit duration when entry created then entry is stopped", func(t *testing.T) {
+		m, fake := newFakeModel(t)
+		m.selectedProject = &harvest.Project{ID: 10, Name: "Website", Client: harvest.ProjectClient{ID: 1, Name: "Acme"}}
+		m.selectedTask = &harvest.Task{ID: 100, Name: "Development"}
+		m.newEntryHours = "1:30"
+
+		m = runCmd(t, m, m.createTimeEntry())
+
+		entries := fake.TimeEntries()
+		if len(entries) != 1 || entries[0].IsRunning || entries[0].Hours != 1.5 {
+			t.Errorf("expected a stopped 1.5h entry, got %+v", entries)
+		}
+		if m.statusMessage != "Time entry created successfully" {
+			t.Errorf("expected created message, got '%s'", m.statusMessage)
+		}
+	})
+
+	t.Run("given new entry form with 0:00 duration when rendered then hints that a timer starts", func(t *testing.T) {
+		m, _ := newFakeModel(t)
+		m.projectsWithTasks = []harvest.ProjectWithTasks{{Project: harvest.Project{ID: 10}, Tasks: []harvest.Task{{ID: 100}}}}
+		newModel, _ := m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("n")})
+		m = newModel.(Model)
+
+		if !strings.Contains(m.View(), "starts a timer") {
+			t.Error("expected form to hint that a timer starts")
+		}
+
+		m.durationInput.SetValue("1:00")
+		if strings.Contains(m.View(), "starts a timer") {
+			t.Error("expected hint to disappear for a non-zero duration")
+		}
+	})
+}
@@ -0,0 +1,84 @@
+package tui
+
+import (
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/planetargon/harvest-tui/internal/harvest"
+)
+
+func TestTimestampEntries(t *testing.T) {
+	t.Run("given a time range when entry created then sends start and end times with derived hours", func(t *testing.T) {
+		m, fake := newFakeModel(t)
+		m.selectedProject = &harvest.Project{ID: 10, Name: "Website", Client: harvest.ProjectClient{ID: 1, Name: "Acme"}}
+		m.selectedTask = &harvest.Task{ID: 100, Name: "Development", Billable: true}
+		m.newEntryHours = "09:15–10:45"
+
+		m = runCmd(t, m, m.createTimeEntry())
+
+		entries := fake.TimeEntries()
+		if len(entries) != 1 {
+			t.Fatalf("expected one entry, got %d", len(entries))
+		}
+		entry := entries[0]
+		if entry.StartedTime != "9:15am" || entry.EndedTime != "10:45am" {
+			t.Errorf("expected 9:15am–10:45am, got %q–%q", entry.StartedTime, entry.EndedTime)
+		}
+		if entry.Hours != 1.5 || entry.IsRunning {
+			t.Errorf("expected a stopped 1.5 hour entry, got %+v", entry)
+		}
+	})
+
+	t.Run("given timestamp entry when edited then form shows its range and saves a new one", func(t *testing.T) {
+		m, fake := newFakeModel(t)
+		entry := fake.AddTimeEntry(harvest.TimeEntry{
+			SpentDate:   "2025-01-15",
+			Hours:       1.5,
+			StartedTime: "9:15am",
+			EndedTime:   "10:45am",
+			Project:     harvest.TimeEntryProject{ID: 10, Name: "Website"},
+			Task:        harvest.TimeEntryTask{ID: 100, Name: "Development"},
+		})
+		m.timeEntries = []harvest.TimeEntry{entry}
+
+		newModel, _ := m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("e")})
+		m = newModel.(Model)
+		if m.editHours != "9:15am–10:45am" {
+			t.Fatalf("expected duration field prefilled with the range, got %q", m.editHours)
+		}
+
+		m.editHours = "9:15am-12:00pm"
+		runCmd(t, m, m.updateTimeEntry())
+
+		stored := fake.TimeEntries()[0]
+		if stored.EndedTime != "12:00pm" || stored.Hours != 2.75 {
+			t.Errorf("expected end 12:00pm and 2.75 hours, got %q and %v", stored.EndedTime, stored.Hours)
+		}
+	})
+
+	t.Run("given timestamp entries when list rendered then shows their time ranges", func(t *testing.T) {
+		m := newTestModel()
+		m.currentView = ViewList
+		m.timeEntries = []harvest.TimeEntry{
+			{ID: 1, Hours: 1.5, StartedTime: "9:15am", EndedTime: "10:45am", Project: harvest.TimeEntryProject{Name: "Website"}},
+			{ID: 2, Hours: 0.5, StartedTime: "1:00pm", IsRunning: true, Project: harvest.TimeEntryProject{Name: "Support"}},
+			{ID: 3, Hours: 2, Project: harvest.TimeEntryProject{Name: "Duration only"}},
+		}
+
+		view := m.View()
+		if !strings.Contains(view, "9:15am–10:45am") {
+			t.Error("expected stopped entry's time range")
+		}
+		if !strings.Contains(view, "1:00pm–") {
+			t.Error("expected running entry's start time")
+		}
+	})
+
+	t.Run("given a time range when typed then preview shows derived duration and times", func(t *testing.T) {
+		preview := newTestModel().renderDurationPreview("9am-1:30pm", -1)
+		if !strings.Contains(preview, "= 4:30 (9:00am–1:30pm)") {
+			t.Errorf("expected range preview, got %q", preview)
+		}
+	})
+}
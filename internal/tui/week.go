@@ -0,0 +1,359 @@
+package tui
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/charmbracelet/bubbles/key"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/planetargon/harvest-tui/internal/harvest"
+)
+
+// daysInWeek is the number of day columns shown in the weekly timesheet.
+const daysInWeek = 7
+
+// weekCellWidth is the rendered width of a single hours cell in the weekly grid.
+const weekCellWidth = 6
+
+// weekRow represents a single project/task row in the weekly timesheet grid.
+type weekRow struct {
+	clientName  string
+	projectID   int
+	projectName string
+	taskID      int
+	taskName    string
+	hours       [daysInWeek]float64
+	running     [daysInWeek]bool
+}
+
+// total returns the sum of hours across the whole week for the row.
+func (r weekRow) total() float64 {
+	total := 0.0
+	for _, h := range r.hours {
+		total += h
+	}
+	return total
+}
+
+// startOfWeek returns midnight on the first day of the week containing date,
+// for weeks that start on first.
+func startOfWeek(date time.Time, first time.Weekday) time.Time {
+	y, mo, d := date.Date()
+	return time.Date(y, mo, d-weekdayColumn(date, first), 0, 0, 0, 0, date.Location())
+}
+
+// weekdayColumn returns the grid column for date, where first is column 0.
+func weekdayColumn(date time.Time, first time.Weekday) int {
+	return (int(date.Weekday()) - int(first) + 7) % 7
+}
+
+// buildWeekRows groups entries into project/task rows with one column per day of the week.
+// Running entries have runningExtra hours added so the grid keeps pace with the live timer.
+// Rows are sorted by client name, then project name, then task name.
+func buildWeekRows(entries []harvest.TimeEntry, weekStart time.Time, runningExtra float64) []weekRow {
+	type rowKey struct{ projectID, taskID int }
+	rowsByKey := make(map[rowKey]*weekRow)
+	var keys []rowKey
+
+	// Map each day of the week to its column by date string, which stays correct across DST changes
+	colByDate := make(map[string]int, daysInWeek)
+	for col := 0; col < daysInWeek; col++ {
+		colByDate[weekStart.AddDate(0, 0, col).Format("2006-01-02")] = col
+	}
+
+	for _, entry := range entries {
+		col, ok := colByDate[entry.SpentDate]
+		if !ok {
+			continue
+		}
+
+		k := rowKey{projectID: entry.Project.ID, taskID: entry.Task.ID}
+		row, ok := rowsByKey[k]
+		if !ok {
+			row = &weekRow{
+				clientName:  entry.Client.Name,
+				projectID:   entry.Project.ID,
+				projectName: entry.Project.Name,
+				taskID:      entry.Task.ID,
+				taskName:    entry.Task.Name,
+			}
+			rowsByKey[k] = row
+			keys = append(keys, k)
+		}
+
+		row.hours[col] += entry.Hours
+		if entry.IsRunning {
+			row.hours[col] += runningExtra
+			row.running[col] = true
+		}
+	}
+
+	rows := make([]weekRow, 0, len(keys))
+	for _, k := range keys {
+		rows = append(rows, *rowsByKey[k])
+	}
+
+	sort.SliceStable(rows, func(i, j int) bool {
+		if rows[i].clientName != rows[j].clientName {
+			return rows[i].clientName < rows[j].clientName
+		}
+		if rows[i].projectName != rows[j].projectName {
+			return rows[i].projectName < rows[j].projectName
+		}
+		return rows[i].taskName < rows[j].taskName
+	})
+
+	return rows
+}
+
+// weekRows returns the grid rows for the currently loaded week.
+func (m Model) weekRows() []weekRow {
+	runningExtra := 0.0
+	if !m.weekFetchTime.IsZero() {
+		runningExtra = time.Since(m.weekFetchTime).Hours()
+	}
+	return buildWeekRows(m.weekEntries, m.weekStart, runningExtra)
+}
+
+// weekHasRunningTimer checks if any entry in the loaded week has a running timer.
+func (m Model) weekHasRunningTimer() bool {
+	for _, entry := range m.weekEntries {
+		if entry.IsRunning {
+			return true
+		}
+	}
+	return false
+}
+
+// openWeekView switches to the weekly timesheet for the week containing currentDate.
+func (m Model) openWeekView() (Model, tea.Cmd) {
+	m.currentView = ViewWeek
+	m.weekStart = startOfWeek(m.currentDate, m.weekStartDay())
+	m.weekColIndex = weekdayColumn(m.currentDate, m.weekStartDay())
+	m.weekRowIndex = 0
+	m.weekLoading = true
+	return m, fetchWeekEntriesCmd(m.newWeekFetchContext(), m.harvestClient, m.weekStart)
+}
+
+// shiftWeek moves the weekly timesheet by the given number of weeks and re-fetches it.
+func (m Model) shiftWeek(weeks int) (Model, tea.Cmd) {
+	m.weekStart = m.weekStart.AddDate(0, 0, 7*weeks)
+	m.weekRowIndex = 0
+	m.weekLoading = true
+	m.clearStatusMessage()
+	return m, fetchWeekEntriesCmd(m.newWeekFetchContext(), m.harvestClient, m.weekStart)
+}
+
+// handleWeekViewKeys handles key presses in the weekly timesheet view.
+func (m Model) handleWeekViewKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	keys := DefaultKeyMap()
+	rowCount := len(m.weekRows())
+
+	switch {
+	case key.Matches(msg, keys.Back), key.Matches(msg, keys.Week), msg.String() == "q":
+		m.currentView = ViewList
+		return m, nil
+
+	case key.Matches(msg, keys.Up):
+		if m.weekRowIndex > 0 {
+			m.weekRowIndex--
+		}
+		return m, nil
+
+	case key.Matches(msg, keys.Down):
+		if m.weekRowIndex < rowCount-1 {
+			m.weekRowIndex++
+		}
+		return m, nil
+
+	case key.Matches(msg, keys.PrevDay):
+		if m.weekColIndex > 0 {
+			m.weekColIndex--
+		}
+		return m, nil
+
+	case key.Matches(msg, keys.NextDay):
+		if m.weekColIndex < daysInWeek-1 {
+			m.weekColIndex++
+		}
+		return m, nil
+
+	case key.Matches(msg, keys.PrevWeek):
+		return m.shiftWeek(-1)
+
+	case key.Matches(msg, keys.NextWeek):
+		return m.shiftWeek(1)
+
+	case key.Matches(msg, keys.Today):
+		m.currentDate = time.Now()
+		return m.openWeekView()
+
+	case key.Matches(msg, keys.Export):
+		return m.openExportForm()
+
+	case key.Matches(msg, keys.Select):
+		// Jump into the daily list for the selected column's date
+		m.currentDate = m.weekStart.AddDate(0, 0, m.weekColIndex)
+		m.currentView = ViewList
+		m.selectedEntryIndex = 0
+		m.loading = true
+		return m, fetchTimeEntriesCmd(m.newTimeEntriesFetchContext(), m.harvestClient, m.currentDate)
+	}
+
+	return m, nil
+}
+
+// renderWeekView renders the weekly timesheet grid with daily and weekly totals.
+func (m Model) renderWeekView() string {
+	width := m.shellWidth()
+
+	titleBar := m.renderTitleBar()
+
+	rows := m.weekRows()
+
+	// Daily and weekly totals
+	var dayTotals [daysInWeek]float64
+	weekTotal := 0.0
+	for _, row := range rows {
+		for col, h := range row.hours {
+			dayTotals[col] += h
+			weekTotal += h
+		}
+	}
+
+	weekEnd := m.weekStart.AddDate(0, 0, daysInWeek-1)
+	headerText := SectionHeaderStyle.Render(fmt.Sprintf("Week of %s – %s",
+		m.weekStart.Format(m.shortDateLayout()), weekEnd.Format(m.shortDateLayout())))
+	totalLabelText := TotalLabel.Render("Total: ")
+	totalValue := TotalValue.Render(m.formatHours(weekTotal))
+	paddingWidth := width - lipgloss.Width(headerText) - lipgloss.Width(totalLabelText) - lipgloss.Width(totalValue) - 4
+	if paddingWidth < 1 {
+		paddingWidth = 1
+	}
+	sectionHeader := "  " + headerText + strings.Repeat(" ", paddingWidth) + totalLabelText + totalValue + "  "
+
+	divider := "  " + RenderDividerWidth(width-4)
+
+	footerKeys := []string{
+		RenderKeybinding("←→↑↓", "move"),
+		RenderKeybinding("[ ]", "week"),
+		RenderKeybinding("enter", "open day"),
+		RenderKeybinding("esc", "back"),
+	}
+
+	if m.weekLoading {
+		content := []string{titleBar, sectionHeader, divider, "    " + MutedText.Render("Loading..."), ""}
+		return m.buildShellBox(strings.Join(content, "\n"), width, footerKeys)
+	}
+
+	if m.errorMessage != "" {
+		content := []string{titleBar, sectionHeader, divider, "    " + ErrorText.Render("Error: "+m.errorMessage), ""}
+		return m.buildShellBox(strings.Join(content, "\n"), width, footerKeys)
+	}
+
+	// Label column takes whatever is left after the seven day columns and the total column
+	labelWidth := width - 6 - (daysInWeek+1)*(weekCellWidth+1)
+	if labelWidth < 10 {
+		labelWidth = 10
+	}
+
+	// Column headers, highlighting today
+	now := time.Now()
+	header := "  " + strings.Repeat(" ", labelWidth)
+	for col := 0; col < daysInWeek; col++ {
+		day := m.weekStart.AddDate(0, 0, col)
+		style := WeekHeaderStyle
+		if day.Year() == now.Year() && day.YearDay() == now.YearDay() {
+			style = WeekTodayHeaderStyle
+		}
+		header += " " + style.Render(day.Format("Mon"))
+	}
+	header += " " + WeekHeaderStyle.Render("Total")
+
+	contentLines := []string{titleBar, sectionHeader, divider, header}
+
+	if len(rows) == 0 {
+		contentLines = append(contentLines, "", "    "+EmptyState.Render("No entries this week."))
+	}
+
+	lastProjectID := -1
+	for i, row := range rows {
+		// Group task rows under a client → project heading
+		if row.projectID != lastProjectID {
+			heading := truncateString(row.clientName, 20) + " → " + truncateString(row.projectName, 30)
+			contentLines = append(contentLines, "  "+ClientStyle.Render(truncateString(heading, width-6)))
+			lastProjectID = row.projectID
+		}
+
+		label := truncateString(row.taskName, labelWidth-5)
+		isRunningRow := false
+		for _, running := range row.running {
+			isRunningRow = isRunningRow || running
+		}
+		labelText := "  " + TaskStyle.Render(label)
+		if isRunningRow {
+			labelText += " " + RunningDot.Render("●")
+		}
+		line := "  " + labelText + strings.Repeat(" ", max(labelWidth-lipgloss.Width(labelText), 0))
+
+		for col := 0; col < daysInWeek; col++ {
+			line += " " + m.renderWeekCell(row.hours[col], row.running[col], i == m.weekRowIndex && col == m.weekColIndex)
+		}
+		line += " " + WeekTotalCellStyle.Render(m.formatHours(row.total()))
+		contentLines = append(contentLines, line)
+	}
+
+	// Daily totals row
+	totalsLine := "  " + TotalLabel.Render("Daily total") + strings.Repeat(" ", max(labelWidth-lipgloss.Width("Daily total"), 0))
+	for col := 0; col < daysInWeek; col++ {
+		totalsLine += " " + WeekTotalCellStyle.Render(m.formatHours(dayTotals[col]))
+	}
+	totalsLine += " " + WeekTotalCellStyle.Render(m.formatHours(weekTotal))
+	contentLines = append(contentLines, "  "+RenderDividerWidth(width-4), totalsLine)
+
+	if statusLine := m.renderStatusLine(); statusLine != "" {
+		contentLines = append(contentLines, "", statusLine)
+	}
+
+	return m.buildShellBox(strings.Join(contentLines, "\n"), width, footerKeys)
+}
+
+// renderWeekCell renders a single hours cell, highlighting the selection and running timers.
+func (m Model) renderWeekCell(hours float64, running, selected bool) string {
+	text := "-"
+	style := WeekEmptyCellStyle
+	if hours > 0 || running {
+		text = m.formatHours(hours)
+		style = WeekCellStyle
+	}
+	if running {
+		style = WeekRunningCellStyle
+	}
+	if selected {
+		style = style.Background(selectedBg)
+	}
+	return style.Render(text)
+}
+
+// weekEntriesFetchedMsg carries the entries for the week starting at weekStart.
+type weekEntriesFetchedMsg struct {
+	weekStart time.Time
+	entries   []harvest.TimeEntry
+	fetchedAt time.Time // When the request was sent, for later incremental syncs
+	err       error
+}
+
+// fetchWeekEntriesCmd fetches all entries for the seven days starting at weekStart in a single range request.
+func fetchWeekEntriesCmd(ctx context.Context, client harvest.API, weekStart time.Time) tea.Cmd {
+	return func() tea.Msg {
+		from := weekStart.Format("2006-01-02")
+		to := weekStart.AddDate(0, 0, daysInWeek-1).Format("2006-01-02")
+		fetchedAt := time.Now()
+		entries, err := client.FetchTimeEntriesRangeContext(ctx, from, to)
+		return weekEntriesFetchedMsg{weekStart: weekStart, entries: entries, fetchedAt: fetchedAt, err: err}
+	}
+}
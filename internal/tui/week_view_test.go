@@ -0,0 +1,350 @@
+package tui
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/planetargon/harvest-tui/internal/harvest"
+)
+
+func newWeekTestEntries() []harvest.TimeEntry {
+	return []harvest.TimeEntry{
+		{
+			ID:        1,
+			SpentDate: "2025-01-13",
+			Hours:     1.5,
+			Client:    harvest.TimeEntryClient{ID: 1, Name: "Acme Corp"},
+			Project:   harvest.TimeEntryProject{ID: 10, Name: "Website"},
+			Task:      harvest.TimeEntryTask{ID: 100, Name: "Development"},
+		},
+		{
+			ID:        2,
+			SpentDate: "2025-01-13",
+			Hours:     0.5,
+			Client:    harvest.TimeEntryClient{ID: 1, Name: "Acme Corp"},
+			Project:   harvest.TimeEntryProject{ID: 10, Name: "Website"},
+			Task:      harvest.TimeEntryTask{ID: 100, Name: "Development"},
+		},
+		{
+			ID:        3,
+			SpentDate: "2025-01-15",
+			Hours:     2.0,
+			IsRunning: true,
+			Client:    harvest.TimeEntryClient{ID: 1, Name: "Acme Corp"},
+			Project:   harvest.TimeEntryProject{ID: 10, Name: "Website"},
+			Task:      harvest.TimeEntryTask{ID: 101, Name: "Meetings"},
+		},
+		{
+			ID:        4,
+			SpentDate: "2025-01-19",
+			Hours:     1.0,
+			Client:    harvest.TimeEntryClient{ID: 2, Name: "BigCo"},
+			Project:   harvest.TimeEntryProject{ID: 20, Name: "Support"},
+			Task:      harvest.TimeEntryTask{ID: 200, Name: "Triage"},
+		},
+	}
+}
+
+func TestStartOfWeek(t *testing.T) {
+	t.Run("given a wednesday when startOfWeek called then returns the preceding monday", func(t *testing.T) {
+		got := startOfWeek(time.Date(2025, 1, 15, 14, 30, 0, 0, time.UTC), time.Monday)
+		want := time.Date(2025, 1, 13, 0, 0, 0, 0, time.UTC)
+		if !got.Equal(want) {
+			t.Errorf("expected %v, got %v", want, got)
+		}
+	})
+
+	t.Run("given a sunday when startOfWeek called then returns the monday six days earlier", func(t *testing.T) {
+		got := startOfWeek(time.Date(2025, 1, 19, 9, 0, 0, 0, time.UTC), time.Monday)
+		want := time.Date(2025, 1, 13, 0, 0, 0, 0, time.UTC)
+		if !got.Equal(want) {
+			t.Errorf("expected %v, got %v", want, got)
+		}
+	})
+
+	t.Run("given a sunday-start week when startOfWeek called on a wednesday then returns the preceding sunday", func(t *testing.T) {
+		date := time.Date(2025, 1, 15, 14, 30, 0, 0, time.UTC)
+		got := startOfWeek(date, time.Sunday)
+		want := time.Date(2025, 1, 12, 0, 0, 0, 0, time.UTC)
+		if !got.Equal(want) {
+			t.Errorf("expected %v, got %v", want, got)
+		}
+		if col := weekdayColumn(date, time.Sunday); col != 3 {
+			t.Errorf("expected wednesday in column 3, got %d", col)
+		}
+	})
+
+	t.Run("given a saturday-start week when startOfWeek called on a friday then returns the saturday six days earlier", func(t *testing.T) {
+		got := startOfWeek(time.Date(2025, 1, 17, 9, 0, 0, 0, time.UTC), time.Saturday)
+		want := time.Date(2025, 1, 11, 0, 0, 0, 0, time.UTC)
+		if !got.Equal(want) {
+			t.Errorf("expected %v, got %v", want, got)
+		}
+	})
+
+	t.Run("given a monday when startOfWeek called then returns the same day at midnight", func(t *testing.T) {
+		got := startOfWeek(time.Date(2025, 1, 13, 23, 59, 0, 0, time.UTC), time.Monday)
+		want := time.Date(2025, 1, 13, 0, 0, 0, 0, time.UTC)
+		if !got.Equal(want) {
+			t.Errorf("expected %v, got %v", want, got)
+		}
+	})
+}
+
+func TestBuildWeekRows(t *testing.T) {
+	weekStart := time.Date(2025, 1, 13, 0, 0, 0, 0, time.UTC)
+
+	t.Run("given entries across the week when grouped then sums hours per project task and day", func(t *testing.T) {
+		rows := buildWeekRows(newWeekTestEntries(), weekStart, 0)
+
+		if len(rows) != 3 {
+			t.Fatalf("expected 3 rows, got %d", len(rows))
+		}
+
+		// Sorted by client, project, task
+		if rows[0].taskName != "Development" || rows[1].taskName != "Meetings" || rows[2].taskName != "Triage" {
+			t.Errorf("unexpected row order: %s, %s, %s", rows[0].taskName, rows[1].taskName, rows[2].taskName)
+		}
+
+		if rows[0].hours[0] != 2.0 {
+			t.Errorf("expected Monday Development total of 2.0, got %f", rows[0].hours[0])
+		}
+		if rows[2].hours[6] != 1.0 {
+			t.Errorf("expected Sunday Triage total of 1.0, got %f", rows[2].hours[6])
+		}
+		if rows[0].total() != 2.0 {
+			t.Errorf("expected Development weekly total of 2.0, got %f", rows[0].total())
+		}
+	})
+
+	t.Run("given running entry when grouped then marks the cell as running and adds elapsed time", func(t *testing.T) {
+		rows := buildWeekRows(newWeekTestEntries(), weekStart, 0.25)
+
+		if !rows[1].running[2] {
+			t.Error("expected Wednesday Meetings cell to be marked running")
+		}
+		if rows[1].hours[2] != 2.25 {
+			t.Errorf("expected running cell to include elapsed time (2.25), got %f", rows[1].hours[2])
+		}
+		if rows[0].running[0] {
+			t.Error("expected stopped cell not to be marked running")
+		}
+	})
+
+	t.Run("given entries outside the week when grouped then ignores them", func(t *testing.T) {
+		entries := []harvest.TimeEntry{
+			{ID: 1, SpentDate: "2025-01-12", Hours: 1.0, Project: harvest.TimeEntryProject{ID: 1}, Task: harvest.TimeEntryTask{ID: 1}},
+			{ID: 2, SpentDate: "2025-01-20", Hours: 1.0, Project: harvest.TimeEntryProject{ID: 1}, Task: harvest.TimeEntryTask{ID: 1}},
+		}
+
+		rows := buildWeekRows(entries, weekStart, 0)
+
+		if len(rows) != 0 {
+			t.Errorf("expected no rows, got %d", len(rows))
+		}
+	})
+}
+
+func TestWeekView(t *testing.T) {
+	t.Run("given list view when w pressed then opens week view and fetches the week", func(t *testing.T) {
+		model := newTestModel()
+		model.currentDate = time.Date(2025, 1, 15, 0, 0, 0, 0, time.UTC)
+
+		msg := tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'w'}}
+		newModel, cmd := model.Update(msg)
+		m := newModel.(Model)
+
+		if m.currentView != ViewWeek {
+			t.Errorf("expected currentView to be ViewWeek, got %v", m.currentView)
+		}
+		if !m.weekStart.Equal(time.Date(2025, 1, 13, 0, 0, 0, 0, time.UTC)) {
+			t.Errorf("expected weekStart to be Monday 2025-01-13, got %v", m.weekStart)
+		}
+		if m.weekColIndex != 2 {
+			t.Errorf("expected Wednesday column to be selected, got %d", m.weekColIndex)
+		}
+		if !m.weekLoading {
+			t.Error("expected weekLoading to be true")
+		}
+		if cmd == nil {
+			t.Error("expected fetch command to be returned")
+		}
+	})
+
+	t.Run("given week entries fetched when message arrives then stores entries", func(t *testing.T) {
+		model := newTestModel()
+		model.currentView = ViewWeek
+		model.weekStart = time.Date(2025, 1, 13, 0, 0, 0, 0, time.UTC)
+		model.weekLoading = true
+
+		newModel, _ := model.Update(weekEntriesFetchedMsg{weekStart: model.weekStart, entries: newWeekTestEntries()})
+		m := newModel.(Model)
+
+		if m.weekLoading {
+			t.Error("expected weekLoading to be false")
+		}
+		if len(m.weekEntries) != 4 {
+			t.Errorf("expected 4 week entries, got %d", len(m.weekEntries))
+		}
+	})
+
+	t.Run("given week fetch for a different week when message arrives then ignores it", func(t *testing.T) {
+		model := newTestModel()
+		model.currentView = ViewWeek
+		model.weekStart = time.Date(2025, 1, 20, 0, 0, 0, 0, time.UTC)
+		model.weekLoading = true
+
+		staleWeek := time.Date(2025, 1, 13, 0, 0, 0, 0, time.UTC)
+		newModel, _ := model.Update(weekEntriesFetchedMsg{weekStart: staleWeek, entries: newWeekTestEntries()})
+		m := newModel.(Model)
+
+		if len(m.weekEntries) != 0 {
+			t.Errorf("expected stale entries to be ignored, got %d", len(m.weekEntries))
+		}
+		if !m.weekLoading {
+			t.Error("expected weekLoading to remain true")
+		}
+	})
+
+	t.Run("given week view when navigation keys pressed then moves the selected cell within bounds", func(t *testing.T) {
+		model := newTestModel()
+		model.currentView = ViewWeek
+		model.weekStart = time.Date(2025, 1, 13, 0, 0, 0, 0, time.UTC)
+		model.weekEntries = newWeekTestEntries()
+		model.weekColIndex = 6
+
+		newModel, _ := model.Update(tea.KeyMsg{Type: tea.KeyRight})
+		m := newModel.(Model)
+		if m.weekColIndex != 6 {
+			t.Errorf("expected column to stay at 6, got %d", m.weekColIndex)
+		}
+
+		newModel, _ = m.Update(tea.KeyMsg{Type: tea.KeyLeft})
+		m = newModel.(Model)
+		if m.weekColIndex != 5 {
+			t.Errorf("expected column 5, got %d", m.weekColIndex)
+		}
+
+		for i := 0; i < 5; i++ {
+			newModel, _ = m.Update(tea.KeyMsg{Type: tea.KeyDown})
+			m = newModel.(Model)
+		}
+		if m.weekRowIndex != 2 {
+			t.Errorf("expected row to stop at last row 2, got %d", m.weekRowIndex)
+		}
+	})
+
+	t.Run("given week view when ] pressed then moves to next week and fetches it", func(t *testing.T) {
+		model := newTestModel()
+		model.currentView = ViewWeek
+		model.weekStart = time.Date(2025, 1, 13, 0, 0, 0, 0, time.UTC)
+
+		newModel, cmd := model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{']'}})
+		m := newModel.(Model)
+
+		if !m.weekStart.Equal(time.Date(2025, 1, 20, 0, 0, 0, 0, time.UTC)) {
+			t.Errorf("expected weekStart 2025-01-20, got %v", m.weekStart)
+		}
+		if cmd == nil {
+			t.Error("expected fetch command to be returned")
+		}
+	})
+
+	t.Run("given selected cell when enter pressed then opens daily list for that date", func(t *testing.T) {
+		model := newTestModel()
+		model.currentView = ViewWeek
+		model.weekStart = time.Date(2025, 1, 13, 0, 0, 0, 0, time.UTC)
+		model.weekEntries = newWeekTestEntries()
+		model.weekColIndex = 4
+		model.selectedEntryIndex = 3
+
+		newModel, cmd := model.Update(tea.KeyMsg{Type: tea.KeyEnter})
+		m := newModel.(Model)
+
+		if m.currentView != ViewList {
+			t.Errorf("expected currentView to be ViewList, got %v", m.currentView)
+		}
+		if m.currentDate.Format("2006-01-02") != "2025-01-17" {
+			t.Errorf("expected currentDate 2025-01-17, got %s", m.currentDate.Format("2006-01-02"))
+		}
+		if m.selectedEntryIndex != 0 {
+			t.Errorf("expected selectedEntryIndex to reset to 0, got %d", m.selectedEntryIndex)
+		}
+		if cmd == nil {
+			t.Error("expected fetch command to be returned")
+		}
+	})
+
+	t.Run("given week view when esc pressed then returns to list view", func(t *testing.T) {
+		model := newTestModel()
+		model.currentView = ViewWeek
+
+		newModel, _ := model.Update(tea.KeyMsg{Type: tea.KeyEsc})
+		m := newModel.(Model)
+
+		if m.currentView != ViewList {
+			t.Errorf("expected currentView to be ViewList, got %v", m.currentView)
+		}
+	})
+}
+
+func TestWeekViewRendering(t *testing.T) {
+	t.Run("given week entries when rendered then shows rows, day headers and totals", func(t *testing.T) {
+		model := newTestModel()
+		model.currentView = ViewWeek
+		model.weekStart = time.Date(2025, 1, 13, 0, 0, 0, 0, time.UTC)
+		model.weekEntries = newWeekTestEntries()
+
+		output := model.View()
+
+		for _, want := range []string{"Week of Jan 13 – Jan 19", "Mon", "Sun", "Development", "Meetings", "Triage", "Acme Corp", "Daily total", "5:00"} {
+			if !strings.Contains(output, want) {
+				t.Errorf("expected output to contain %q", want)
+			}
+		}
+	})
+
+	t.Run("given week view when rendered then all box lines have equal width", func(t *testing.T) {
+		model := newTestModel()
+		model.currentView = ViewWeek
+		model.weekStart = time.Date(2025, 1, 13, 0, 0, 0, 0, time.UTC)
+		model.weekEntries = newWeekTestEntries()
+
+		lines := strings.Split(model.View(), "\n")
+		topBorderWidth := lipgloss.Width(lines[0])
+		for i, line := range lines {
+			if lipgloss.Width(line) != topBorderWidth {
+				t.Errorf("line %d has width %d, expected %d\nline: %q", i, lipgloss.Width(line), topBorderWidth, line)
+			}
+		}
+	})
+
+	t.Run("given no entries when rendered then shows empty week message", func(t *testing.T) {
+		model := newTestModel()
+		model.currentView = ViewWeek
+		model.weekStart = time.Date(2025, 1, 13, 0, 0, 0, 0, time.UTC)
+
+		output := model.View()
+
+		if !strings.Contains(output, "No entries this week") {
+			t.Error("expected empty week message")
+		}
+	})
+
+	t.Run("given fetch error when rendered then shows error", func(t *testing.T) {
+		model := newTestModel()
+		model.currentView = ViewWeek
+		model.weekStart = time.Date(2025, 1, 13, 0, 0, 0, 0, time.UTC)
+
+		newModel, _ := model.Update(weekEntriesFetchedMsg{weekStart: model.weekStart, err: fmt.Errorf("network error")})
+		output := newModel.(Model).View()
+
+		if !strings.Contains(output, "network error") {
+			t.Error("expected output to contain the fetch error")
+		}
+	})
+}